@@ -1,1261 +0,0 @@
-/*
- Navicat Premium Dump SQL
-
- Source Server         : campus_activity
- Source Server Type    : MySQL
- Source Server Version : 80041 (8.0.41)
- Source Host           : localhost:3306
- Source Schema         : campus_activity
-
- Target Server Type    : MySQL
- Target Server Version : 80041 (8.0.41)
- File Encoding         : 65001
-
- Date: 01/11/2025 21:14:07
-*/
-
-SET NAMES utf8mb4;
-SET FOREIGN_KEY_CHECKS = 0;
-
--- ----------------------------
--- Table structure for activities
--- ----------------------------
-DROP TABLE IF EXISTS `activities`;
-CREATE TABLE `activities`  (
-  `id` int NOT NULL AUTO_INCREMENT,
-  `title` varchar(100) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '活动标题',
-  `description` text CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NULL COMMENT '活动详情',
-  `category` varchar(50) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NULL DEFAULT NULL COMMENT '活动分类 (如: 学术讲座, 文体竞赛)',
-  `organizer` varchar(100) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '举办方',
-  `location` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NULL DEFAULT NULL COMMENT '活动地点',
-  `start_time` datetime NOT NULL COMMENT '开始时间',
-  `end_time` datetime NOT NULL COMMENT '结束时间',
-  `capacity` int NULL DEFAULT 0 COMMENT '活动容量 (0为不限)',
-  `created_by_id` int NULL DEFAULT NULL COMMENT '发布者ID',
-  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP,
-  PRIMARY KEY (`id`) USING BTREE,
-  INDEX `created_by_id`(`created_by_id` ASC) USING BTREE,
-  CONSTRAINT `activities_ibfk_1` FOREIGN KEY (`created_by_id`) REFERENCES `users` (`id`) ON DELETE SET NULL ON UPDATE RESTRICT
-) ENGINE = InnoDB AUTO_INCREMENT = 54 CHARACTER SET = utf8mb4 COLLATE = utf8mb4_unicode_ci COMMENT = '活动表' ROW_FORMAT = DYNAMIC;
-
--- ----------------------------
--- Records of activities
--- ----------------------------
-INSERT INTO `activities` VALUES (1, '数据库课程设计讲座', '讲解如何高效完成数据库课程设计...', '学术讲座', '计算机学院', '教3-201', '2025-08-25 19:00:00', '2025-08-25 21:00:00', 100, 1, '2025-08-20 22:38:36');
-INSERT INTO `activities` VALUES (2, '迎新篮球赛', '计算机学院 vs 外国语学院', '文体竞赛', '校学生会', '主体育馆', '2025-08-26 15:00:00', '2025-08-26 17:00:00', 500, 1, '2025-08-20 22:38:36');
-INSERT INTO `activities` VALUES (3, '吉他社招新', '来加入我们，一起弹琴吧！', '社团招新', '吉他社', '大学生活动中心', '2025-08-27 12:00:00', '2025-08-27 14:00:00', 0, 1, '2025-08-20 22:38:36');
-INSERT INTO `activities` VALUES (4, '学术讲座 - 活动编号4', '这是一个自动生成的测试活动描述，编号为 4。', '学术讲座', '校学生会', '教1-101', '2025-10-03 10:46:21', '2025-10-24 10:46:21', 382, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (5, '文体竞赛 - 活动编号5', '这是一个自动生成的测试活动描述，编号为 5。', '文体竞赛', '青年志愿者协会', '教2-202', '2025-10-16 10:46:21', '2025-10-23 10:46:21', 88, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (6, '社团招新 - 活动编号6', '这是一个自动生成的测试活动描述，编号为 6。', '社团招新', '计算机科学与技术学院', '教3-303', '2025-09-23 10:46:21', '2025-10-23 10:46:21', 196, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (7, '志愿服务 - 活动编号7', '这是一个自动生成的测试活动描述，编号为 7。', '志愿服务', '艺术团', '教4-404', '2025-09-02 10:46:21', '2025-10-24 10:46:21', 248, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (8, '学术讲座 - 活动编号8', '这是一个自动生成的测试活动描述，编号为 8。', '学术讲座', '数据科学社', '教5-505', '2025-10-13 10:46:21', '2025-10-24 10:46:21', 39, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (9, '文体竞赛 - 活动编号9', '这是一个自动生成的测试活动描述，编号为 9。', '文体竞赛', '校学生会', '教6-106', '2025-09-14 10:46:21', '2025-10-24 10:46:21', 350, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (10, '社团招新 - 活动编号10', '这是一个自动生成的测试活动描述，编号为 10。', '社团招新', '青年志愿者协会', '教7-207', '2025-10-21 10:46:21', '2025-10-24 10:46:21', 457, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (12, '学术讲座 - 活动编号12', '这是一个自动生成的测试活动描述，编号为 12。', '学术讲座', '艺术团', '教9-409', '2025-10-19 10:46:21', '2025-10-24 10:46:21', 449, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (13, '文体竞赛 - 活动编号13', '这是一个自动生成的测试活动描述，编号为 13。', '文体竞赛', '数据科学社', '教10-501', '2025-09-28 10:46:21', '2025-10-23 10:46:21', 226, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (14, '社团招新 - 活动编号14', '这是一个自动生成的测试活动描述，编号为 14。', '社团招新', '校学生会', '教1-102', '2025-10-02 10:46:21', '2025-10-23 10:46:21', 398, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (15, '志愿服务 - 活动编号15', '这是一个自动生成的测试活动描述，编号为 15。', '志愿服务', '青年志愿者协会', '教2-203', '2025-09-07 10:46:21', '2025-10-23 10:46:21', 182, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (16, '学术讲座 - 活动编号16', '这是一个自动生成的测试活动描述，编号为 16。', '学术讲座', '计算机科学与技术学院', '教3-304', '2025-09-23 10:46:21', '2025-10-24 10:46:21', 288, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (17, '文体竞赛 - 活动编号17', '这是一个自动生成的测试活动描述，编号为 17。', '文体竞赛', '艺术团', '教4-405', '2025-10-08 10:46:21', '2025-10-23 10:46:21', 60, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (18, '社团招新 - 活动编号18', '这是一个自动生成的测试活动描述，编号为 18。', '社团招新', '数据科学社', '教5-506', '2025-09-22 10:46:21', '2025-10-23 10:46:21', 469, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (19, '志愿服务 - 活动编号19', '这是一个自动生成的测试活动描述，编号为 19。', '志愿服务', '校学生会', '教6-107', '2025-09-27 10:46:21', '2025-10-23 10:46:21', 355, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (20, '学术讲座 - 活动编号20', '这是一个自动生成的测试活动描述，编号为 20。', '学术讲座', '青年志愿者协会', '教7-208', '2025-09-17 10:46:21', '2025-10-23 10:46:21', 226, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (21, '文体竞赛 - 活动编号21', '这是一个自动生成的测试活动描述，编号为 21。', '文体竞赛', '计算机科学与技术学院', '教8-309', '2025-09-27 10:46:21', '2025-10-24 10:46:21', 441, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (23, '志愿服务 - 活动编号23', '这是一个自动生成的测试活动描述，编号为 23。', '志愿服务', '数据科学社', '教10-502', '2025-08-31 10:46:21', '2025-10-24 10:46:21', 249, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (24, '学术讲座 - 活动编号24', '这是一个自动生成的测试活动描述，编号为 24。', '学术讲座', '校学生会', '教1-103', '2025-10-16 10:46:21', '2025-10-23 10:46:21', 84, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (25, '文体竞赛 - 活动编号25', '这是一个自动生成的测试活动描述，编号为 25。', '文体竞赛', '青年志愿者协会', '教2-204', '2025-08-28 10:46:21', '2025-10-23 10:46:21', 460, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (26, '社团招新 - 活动编号26', '这是一个自动生成的测试活动描述，编号为 26。', '社团招新', '计算机科学与技术学院', '教3-305', '2025-09-09 10:46:21', '2025-10-24 10:46:21', 516, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (27, '志愿服务 - 活动编号27', '这是一个自动生成的测试活动描述，编号为 27。', '志愿服务', '艺术团', '教4-406', '2025-09-29 10:46:21', '2025-10-23 10:46:21', 344, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (28, '学术讲座 - 活动编号28', '这是一个自动生成的测试活动描述，编号为 28。', '学术讲座', '数据科学社', '教5-507', '2025-10-16 10:46:21', '2025-10-24 10:46:21', 82, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (29, '文体竞赛 - 活动编号29', '这是一个自动生成的测试活动描述，编号为 29。', '文体竞赛', '校学生会', '教6-108', '2025-10-14 10:46:21', '2025-10-24 10:46:21', 194, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (30, '社团招新 - 活动编号30', '这是一个自动生成的测试活动描述，编号为 30。', '社团招新', '青年志愿者协会', '教7-209', '2025-10-05 10:46:21', '2025-10-24 10:46:21', 308, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (31, '志愿服务 - 活动编号31', '这是一个自动生成的测试活动描述，编号为 31。', '志愿服务', '计算机科学与技术学院', '教8-301', '2025-09-04 10:46:21', '2025-10-23 10:46:21', 383, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (32, '学术讲座 - 活动编号32', '这是一个自动生成的测试活动描述，编号为 32。', '学术讲座', '艺术团', '教9-402', '2025-10-09 10:46:21', '2025-10-24 10:46:21', 296, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (33, '文体竞赛 - 活动编号33', '这是一个自动生成的测试活动描述，编号为 33。', '文体竞赛', '数据科学社', '教10-503', '2025-09-13 10:46:21', '2025-10-23 10:46:21', 273, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (34, '社团招新 - 活动编号34', '这是一个自动生成的测试活动描述，编号为 34。', '社团招新', '校学生会', '教1-104', '2025-08-31 10:46:21', '2025-10-23 10:46:21', 337, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (35, '志愿服务 - 活动编号35', '这是一个自动生成的测试活动描述，编号为 35。', '志愿服务', '青年志愿者协会', '教2-205', '2025-09-20 10:46:21', '2025-10-23 10:46:21', 516, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (36, '学术讲座 - 活动编号36', '这是一个自动生成的测试活动描述，编号为 36。', '学术讲座', '计算机科学与技术学院', '教3-306', '2025-09-19 10:46:21', '2025-10-23 10:46:21', 129, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (37, '文体竞赛 - 活动编号37', '这是一个自动生成的测试活动描述，编号为 37。', '文体竞赛', '艺术团', '教4-407', '2025-08-28 10:46:21', '2025-10-24 10:46:21', 350, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (38, '社团招新 - 活动编号38', '这是一个自动生成的测试活动描述，编号为 38。', '社团招新', '数据科学社', '教5-508', '2025-10-16 10:46:21', '2025-10-24 10:46:21', 474, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (39, '志愿服务 - 活动编号39', '这是一个自动生成的测试活动描述，编号为 39。', '志愿服务', '校学生会', '教6-109', '2025-10-17 10:46:21', '2025-10-24 10:46:21', 434, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (40, '学术讲座 - 活动编号40', '这是一个自动生成的测试活动描述，编号为 40。', '学术讲座', '青年志愿者协会', '教7-201', '2025-09-11 10:46:21', '2025-10-23 10:46:21', 412, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (41, '文体竞赛 - 活动编号41', '这是一个自动生成的测试活动描述，编号为 41。', '文体竞赛', '计算机科学与技术学院', '教8-302', '2025-09-17 10:46:21', '2025-10-24 10:46:21', 207, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (42, '社团招新 - 活动编号42', '这是一个自动生成的测试活动描述，编号为 42。', '社团招新', '艺术团', '教9-403', '2025-10-01 10:46:21', '2025-10-23 10:46:21', 420, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (43, '志愿服务 - 活动编号43', '这是一个自动生成的测试活动描述，编号为 43。', '志愿服务', '数据科学社', '教10-504', '2025-09-22 10:46:21', '2025-10-23 10:46:21', 507, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (44, '学术讲座 - 活动编号44', '这是一个自动生成的测试活动描述，编号为 44。', '学术讲座', '校学生会', '教1-105', '2025-09-24 10:46:21', '2025-10-24 10:46:21', 194, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (45, '文体竞赛 - 活动编号45', '这是一个自动生成的测试活动描述，编号为 45。', '文体竞赛', '青年志愿者协会', '教2-206', '2025-09-10 10:46:21', '2025-10-23 10:46:21', 295, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (46, '社团招新 - 活动编号46', '这是一个自动生成的测试活动描述，编号为 46。', '社团招新', '计算机科学与技术学院', '教3-307', '2025-09-05 10:46:21', '2025-10-23 10:46:21', 306, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (47, '志愿服务 - 活动编号47', '这是一个自动生成的测试活动描述，编号为 47。', '志愿服务', '艺术团', '教4-408', '2025-09-20 10:46:21', '2025-10-24 10:46:21', 504, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (48, '学术讲座 - 活动编号48', '这是一个自动生成的测试活动描述，编号为 48。', '学术讲座', '数据科学社', '教5-509', '2025-10-07 10:46:21', '2025-10-24 10:46:21', 42, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (49, '文体竞赛 - 活动编号49', '这是一个自动生成的测试活动描述，编号为 49。', '文体竞赛', '校学生会', '教6-101', '2025-09-27 10:46:21', '2025-10-24 10:46:21', 219, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (50, '社团招新 - 活动编号50', '这是一个自动生成的测试活动描述，编号为 50。', '社团招新', '青年志愿者协会', '教7-202', '2025-09-18 10:46:21', '2025-10-24 10:46:21', 375, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (51, '志愿服务 - 活动编号51', '这是一个自动生成的测试活动描述，编号为 51。', '志愿服务', '计算机科学与技术学院', '教8-303', '2025-09-21 10:46:21', '2025-10-23 10:46:21', 526, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (52, '学术讲座 - 活动编号52', '这是一个自动生成的测试活动描述，编号为 52。', '学术讲座', '艺术团', '教9-404', '2025-08-31 10:46:21', '2025-10-24 10:46:21', 404, 1, '2025-08-23 10:46:21');
-INSERT INTO `activities` VALUES (53, '文体竞赛 - 活动编号53', '这是一个自动生成的测试活动描述，编号为 53。', '文体竞赛', '数据科学社', '教10-505', '2025-10-15 10:46:21', '2025-10-23 10:46:21', 73, 1, '2025-08-23 10:46:21');
-
--- ----------------------------
--- Table structure for registrations
--- ----------------------------
-DROP TABLE IF EXISTS `registrations`;
-CREATE TABLE `registrations`  (
-  `id` int NOT NULL AUTO_INCREMENT,
-  `user_id` int NOT NULL COMMENT '用户ID',
-  `activity_id` int NOT NULL COMMENT '活动ID',
-  `registration_time` timestamp NULL DEFAULT CURRENT_TIMESTAMP,
-  `status` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'pending',
-  PRIMARY KEY (`id`) USING BTREE,
-  UNIQUE INDEX `user_activity_unique`(`user_id` ASC, `activity_id` ASC) USING BTREE COMMENT '确保用户对同一活动只能报名一次',
-  INDEX `activity_id`(`activity_id` ASC) USING BTREE,
-  CONSTRAINT `registrations_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE ON UPDATE RESTRICT,
-  CONSTRAINT `registrations_ibfk_2` FOREIGN KEY (`activity_id`) REFERENCES `activities` (`id`) ON DELETE CASCADE ON UPDATE RESTRICT
-) ENGINE = InnoDB AUTO_INCREMENT = 1007 CHARACTER SET = utf8mb4 COLLATE = utf8mb4_unicode_ci COMMENT = '报名记录表' ROW_FORMAT = DYNAMIC;
-
--- ----------------------------
--- Records of registrations
--- ----------------------------
-INSERT INTO `registrations` VALUES (2, 3, 1, '2025-08-20 22:38:36', 'approved');
-INSERT INTO `registrations` VALUES (3, 3, 2, '2025-08-20 22:38:36', 'approved');
-INSERT INTO `registrations` VALUES (4, 4, 41, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (5, 158, 34, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (6, 141, 35, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (8, 153, 34, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (9, 159, 7, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (10, 14, 5, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (11, 197, 42, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (12, 193, 24, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (13, 54, 4, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (14, 63, 26, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (16, 169, 5, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (18, 147, 29, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (19, 87, 30, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (20, 90, 31, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (21, 99, 40, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (22, 38, 38, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (23, 200, 44, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (24, 20, 4, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (25, 155, 42, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (26, 129, 43, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (27, 21, 6, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (28, 10, 53, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (29, 171, 15, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (30, 123, 19, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (31, 164, 6, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (33, 35, 20, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (34, 46, 6, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (35, 132, 6, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (36, 59, 17, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (37, 106, 41, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (38, 44, 43, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (39, 61, 9, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (40, 134, 51, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (41, 166, 13, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (43, 32, 15, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (44, 155, 7, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (45, 27, 21, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (46, 89, 7, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (47, 14, 6, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (48, 32, 30, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (49, 49, 31, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (50, 12, 33, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (51, 159, 10, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (52, 72, 19, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (53, 109, 38, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (54, 184, 25, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (55, 90, 47, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (56, 15, 37, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (57, 48, 8, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (58, 150, 25, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (59, 193, 26, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (60, 78, 31, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (61, 123, 21, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (62, 199, 45, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (63, 38, 24, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (64, 106, 20, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (65, 36, 43, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (66, 91, 45, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (67, 174, 42, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (68, 53, 52, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (69, 19, 28, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (70, 46, 33, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (71, 66, 43, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (72, 13, 46, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (73, 24, 52, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (74, 110, 42, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (75, 46, 42, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (76, 47, 42, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (77, 45, 39, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (78, 195, 35, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (79, 63, 33, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (80, 17, 31, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (82, 19, 50, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (83, 89, 20, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (84, 80, 49, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (86, 123, 47, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (87, 112, 9, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (88, 187, 17, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (89, 115, 4, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (90, 74, 41, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (91, 138, 10, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (92, 124, 36, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (93, 85, 8, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (94, 58, 7, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (95, 107, 23, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (96, 86, 48, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (97, 41, 17, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (98, 168, 17, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (99, 187, 41, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (100, 9, 47, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (101, 61, 44, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (102, 37, 25, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (103, 124, 41, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (104, 186, 20, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (105, 190, 37, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (106, 107, 33, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (107, 91, 23, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (108, 134, 7, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (109, 90, 51, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (110, 84, 13, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (111, 156, 15, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (112, 169, 27, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (113, 182, 5, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (114, 96, 15, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (115, 161, 15, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (116, 160, 15, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (118, 94, 45, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (119, 153, 17, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (120, 28, 43, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (121, 120, 31, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (122, 6, 24, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (123, 8, 47, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (124, 56, 40, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (125, 167, 50, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (126, 40, 10, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (128, 10, 52, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (129, 164, 7, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (130, 194, 31, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (131, 188, 51, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (132, 195, 51, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (133, 174, 25, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (134, 127, 43, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (135, 18, 4, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (136, 167, 7, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (137, 183, 17, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (138, 141, 34, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (139, 196, 4, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (140, 28, 34, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (141, 138, 30, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (142, 133, 36, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (143, 57, 24, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (144, 55, 6, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (145, 104, 21, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (146, 53, 13, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (147, 36, 17, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (148, 178, 30, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (149, 20, 43, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (150, 150, 17, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (151, 34, 51, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (152, 67, 39, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (153, 131, 6, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (155, 202, 44, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (156, 21, 4, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (157, 161, 49, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (158, 34, 6, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (159, 163, 46, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (160, 168, 33, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (161, 94, 28, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (162, 33, 15, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (163, 152, 53, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (164, 155, 44, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (165, 149, 16, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (166, 12, 27, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (167, 51, 42, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (169, 95, 12, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (170, 91, 39, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (171, 42, 47, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (172, 158, 16, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (173, 189, 48, '2025-08-23 10:46:21', 'approved');
-INSERT INTO `registrations` VALUES (174, 139, 39, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (175, 101, 20, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (176, 44, 4, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (177, 92, 12, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (178, 115, 16, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (179, 125, 17, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (180, 117, 4, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (181, 66, 31, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (182, 161, 19, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (183, 40, 52, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (184, 73, 44, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (185, 201, 30, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (186, 133, 37, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (187, 89, 9, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (188, 53, 50, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (189, 178, 33, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (190, 75, 4, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (191, 196, 43, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (192, 22, 7, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (193, 15, 8, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (194, 63, 13, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (195, 21, 46, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (196, 9, 31, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (197, 141, 43, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (198, 175, 50, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (199, 24, 38, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (200, 39, 44, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (201, 105, 9, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (202, 15, 51, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (203, 110, 46, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (204, 125, 29, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (205, 153, 13, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (206, 146, 53, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (207, 166, 8, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (208, 203, 40, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (209, 126, 49, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (210, 146, 45, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (211, 9, 35, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (214, 73, 50, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (215, 136, 28, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (216, 94, 44, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (217, 136, 49, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (218, 112, 53, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (219, 71, 38, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (220, 100, 19, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (221, 31, 40, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (222, 54, 6, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (223, 112, 31, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (224, 18, 40, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (225, 99, 12, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (226, 87, 32, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (227, 133, 28, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (228, 106, 8, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (229, 188, 21, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (230, 195, 41, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (231, 182, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (232, 77, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (233, 199, 15, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (234, 47, 24, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (235, 77, 35, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (237, 160, 26, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (238, 178, 4, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (239, 99, 20, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (240, 54, 16, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (241, 97, 34, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (242, 124, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (243, 52, 32, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (244, 35, 7, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (245, 171, 53, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (246, 96, 20, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (247, 52, 16, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (248, 103, 41, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (249, 49, 49, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (250, 177, 34, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (251, 97, 28, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (252, 8, 37, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (253, 55, 18, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (254, 135, 25, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (255, 34, 28, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (256, 195, 21, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (257, 171, 12, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (258, 71, 12, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (259, 178, 45, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (260, 114, 16, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (262, 116, 53, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (263, 50, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (264, 80, 17, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (265, 43, 12, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (266, 58, 46, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (267, 92, 37, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (268, 198, 48, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (269, 100, 42, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (270, 86, 41, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (272, 49, 7, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (273, 131, 53, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (274, 18, 21, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (275, 121, 46, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (276, 110, 8, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (277, 168, 46, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (278, 167, 29, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (279, 33, 12, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (280, 86, 31, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (281, 111, 5, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (282, 102, 23, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (283, 104, 20, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (284, 25, 32, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (285, 110, 51, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (286, 34, 49, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (287, 28, 46, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (288, 184, 51, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (291, 158, 6, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (292, 187, 26, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (293, 101, 8, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (294, 7, 44, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (295, 193, 21, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (297, 49, 4, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (299, 136, 8, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (300, 93, 52, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (301, 111, 41, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (302, 32, 27, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (303, 181, 6, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (304, 128, 51, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (305, 172, 23, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (306, 91, 53, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (307, 141, 26, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (308, 38, 30, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (309, 22, 48, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (311, 64, 5, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (312, 57, 15, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (313, 79, 12, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (314, 156, 17, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (315, 16, 29, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (316, 68, 9, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (317, 116, 28, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (318, 163, 28, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (319, 21, 51, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (320, 109, 42, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (321, 51, 48, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (322, 154, 8, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (323, 41, 37, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (324, 160, 49, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (325, 37, 10, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (327, 80, 44, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (328, 191, 15, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (331, 156, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (332, 156, 12, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (333, 121, 25, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (334, 76, 30, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (335, 111, 9, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (336, 195, 26, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (337, 75, 26, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (338, 44, 36, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (339, 131, 15, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (340, 55, 32, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (341, 28, 47, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (342, 4, 23, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (343, 201, 40, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (344, 144, 20, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (345, 104, 31, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (346, 51, 30, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (347, 192, 9, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (348, 157, 28, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (349, 32, 16, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (350, 160, 13, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (351, 125, 27, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (352, 110, 16, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (353, 126, 21, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (354, 181, 24, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (355, 78, 36, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (356, 25, 33, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (357, 138, 31, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (358, 159, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (359, 149, 4, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (361, 54, 45, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (362, 72, 16, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (363, 54, 28, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (364, 137, 48, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (365, 85, 24, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (366, 160, 39, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (367, 36, 38, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (368, 7, 52, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (369, 172, 18, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (370, 183, 35, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (371, 92, 21, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (372, 78, 46, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (373, 27, 5, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (374, 169, 6, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (375, 148, 27, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (376, 48, 38, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (377, 157, 42, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (378, 117, 29, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (379, 179, 46, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (380, 124, 27, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (381, 113, 20, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (382, 202, 53, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (383, 191, 41, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (384, 181, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (385, 89, 27, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (387, 164, 52, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (389, 96, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (390, 140, 42, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (391, 162, 37, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (392, 195, 43, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (393, 13, 48, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (394, 67, 48, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (395, 113, 6, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (396, 117, 39, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (397, 181, 17, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (398, 146, 40, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (399, 115, 32, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (400, 42, 16, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (401, 135, 31, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (402, 162, 18, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (403, 23, 33, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (404, 141, 37, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (405, 52, 15, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (406, 93, 30, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (407, 59, 44, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (408, 52, 42, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (409, 30, 21, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (410, 69, 33, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (411, 4, 15, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (412, 26, 48, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (413, 24, 46, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (414, 198, 18, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (415, 112, 45, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (416, 112, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (417, 87, 26, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (418, 10, 43, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (419, 182, 7, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (421, 148, 12, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (422, 141, 50, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (424, 51, 31, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (425, 16, 36, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (426, 20, 26, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (427, 8, 41, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (428, 135, 6, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (429, 54, 10, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (430, 189, 15, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (431, 70, 53, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (432, 197, 47, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (433, 84, 25, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (434, 193, 25, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (435, 73, 25, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (436, 22, 13, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (437, 136, 41, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (438, 151, 26, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (439, 8, 42, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (440, 166, 40, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (441, 52, 53, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (443, 8, 4, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (444, 202, 50, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (445, 129, 23, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (446, 10, 4, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (447, 195, 42, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (448, 189, 21, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (449, 199, 46, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (450, 56, 43, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (451, 41, 31, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (452, 36, 12, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (453, 80, 24, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (454, 175, 8, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (455, 170, 50, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (456, 27, 45, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (457, 155, 20, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (458, 72, 41, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (460, 152, 17, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (462, 122, 18, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (463, 129, 18, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (464, 122, 7, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (465, 125, 43, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (466, 39, 28, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (467, 194, 17, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (468, 96, 30, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (469, 57, 41, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (470, 187, 21, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (471, 198, 45, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (472, 51, 39, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (473, 161, 45, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (474, 164, 29, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (475, 31, 12, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (476, 88, 34, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (477, 157, 4, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (478, 159, 46, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (480, 130, 34, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (481, 40, 7, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (482, 154, 33, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (483, 137, 32, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (484, 178, 36, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (485, 123, 6, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (486, 101, 17, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (487, 174, 28, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (488, 173, 43, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (489, 84, 36, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (490, 16, 21, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (491, 120, 46, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (492, 104, 52, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (493, 71, 42, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (494, 171, 48, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (495, 187, 50, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (496, 187, 43, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (497, 43, 34, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (498, 90, 21, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (500, 113, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (501, 92, 32, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (502, 104, 44, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (503, 105, 10, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (504, 26, 13, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (505, 124, 26, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (506, 97, 52, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (507, 87, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (510, 92, 43, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (511, 134, 47, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (512, 75, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (513, 195, 12, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (514, 192, 15, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (515, 70, 51, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (516, 162, 8, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (518, 100, 52, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (519, 80, 4, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (520, 191, 36, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (521, 83, 7, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (522, 32, 29, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (523, 26, 5, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (525, 45, 32, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (526, 57, 34, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (527, 50, 20, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (528, 202, 51, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (529, 163, 10, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (530, 51, 43, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (531, 59, 4, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (532, 42, 51, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (533, 30, 45, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (534, 159, 23, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (535, 122, 44, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (536, 64, 7, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (537, 83, 44, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (538, 174, 45, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (539, 120, 25, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (540, 91, 47, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (541, 7, 28, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (542, 90, 37, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (543, 11, 12, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (544, 161, 24, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (546, 99, 9, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (547, 30, 20, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (548, 51, 13, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (549, 60, 44, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (551, 151, 35, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (552, 197, 50, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (553, 161, 9, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (554, 42, 36, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (555, 131, 16, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (556, 73, 52, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (557, 169, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (558, 119, 17, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (559, 117, 7, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (560, 126, 47, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (561, 107, 52, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (562, 68, 38, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (563, 94, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (564, 141, 44, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (565, 200, 28, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (566, 98, 49, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (567, 26, 45, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (568, 177, 44, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (569, 93, 44, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (570, 144, 8, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (571, 76, 29, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (572, 106, 4, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (573, 101, 24, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (574, 125, 44, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (575, 43, 32, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (576, 59, 37, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (577, 102, 26, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (578, 167, 39, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (579, 25, 23, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (580, 136, 10, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (581, 132, 45, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (582, 50, 37, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (583, 137, 20, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (584, 128, 10, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (585, 156, 26, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (586, 190, 20, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (587, 165, 8, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (588, 200, 37, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (589, 78, 47, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (590, 43, 24, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (591, 91, 52, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (592, 115, 46, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (593, 128, 30, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (594, 165, 25, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (595, 152, 25, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (596, 178, 9, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (597, 186, 15, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (598, 95, 32, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (599, 100, 38, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (600, 12, 9, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (601, 89, 44, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (602, 156, 23, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (604, 75, 34, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (605, 7, 15, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (606, 24, 45, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (607, 162, 29, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (608, 34, 16, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (609, 161, 13, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (610, 118, 18, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (611, 153, 47, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (612, 21, 45, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (613, 185, 5, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (615, 86, 33, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (616, 152, 50, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (617, 93, 25, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (618, 167, 43, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (619, 101, 7, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (620, 189, 23, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (621, 44, 45, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (622, 111, 13, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (623, 71, 9, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (624, 115, 26, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (625, 116, 27, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (626, 140, 53, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (627, 183, 30, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (628, 191, 9, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (629, 151, 21, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (630, 116, 41, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (631, 17, 7, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (632, 38, 36, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (633, 147, 36, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (634, 17, 23, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (636, 54, 9, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (637, 153, 25, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (639, 188, 33, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (640, 42, 13, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (641, 69, 9, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (643, 45, 51, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (645, 23, 26, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (647, 69, 19, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (648, 118, 50, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (649, 197, 5, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (650, 48, 6, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (651, 111, 31, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (652, 38, 13, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (653, 99, 43, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (655, 202, 37, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (656, 88, 7, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (657, 19, 13, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (658, 148, 5, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (660, 165, 10, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (661, 41, 33, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (662, 72, 52, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (663, 160, 5, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (664, 166, 51, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (665, 72, 46, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (666, 51, 35, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (667, 86, 13, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (669, 201, 45, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (670, 50, 36, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (671, 109, 39, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (672, 195, 36, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (673, 87, 9, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (674, 66, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (675, 37, 12, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (676, 72, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (677, 11, 31, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (679, 17, 15, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (680, 189, 52, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (681, 8, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (682, 9, 27, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (684, 155, 21, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (685, 110, 32, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (686, 58, 35, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (687, 80, 53, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (688, 165, 6, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (689, 180, 15, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (690, 103, 44, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (691, 106, 12, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (692, 60, 49, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (693, 153, 52, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (694, 131, 17, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (695, 84, 15, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (696, 187, 49, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (697, 163, 17, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (698, 190, 47, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (699, 110, 7, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (701, 140, 20, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (702, 117, 47, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (703, 136, 38, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (704, 104, 24, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (705, 110, 26, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (706, 134, 49, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (707, 125, 18, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (708, 126, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (709, 47, 26, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (710, 131, 44, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (711, 29, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (712, 145, 48, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (713, 62, 44, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (714, 44, 32, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (715, 54, 31, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (716, 197, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (717, 24, 49, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (718, 49, 25, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (719, 89, 47, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (720, 25, 49, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (721, 41, 15, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (722, 114, 8, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (723, 164, 41, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (724, 64, 17, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (725, 102, 35, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (726, 132, 20, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (727, 151, 38, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (729, 144, 18, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (730, 70, 44, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (731, 5, 36, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (734, 197, 26, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (736, 172, 42, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (737, 72, 23, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (738, 188, 25, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (740, 110, 24, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (741, 95, 6, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (742, 177, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (743, 84, 24, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (744, 172, 53, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (745, 78, 50, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (746, 108, 45, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (747, 118, 23, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (748, 45, 48, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (749, 160, 17, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (750, 4, 13, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (751, 201, 21, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (752, 162, 49, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (753, 35, 6, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (754, 167, 49, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (755, 24, 42, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (756, 120, 33, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (757, 41, 12, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (758, 58, 47, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (759, 107, 53, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (760, 83, 5, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (761, 187, 30, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (762, 179, 44, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (763, 78, 27, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (764, 47, 38, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (765, 165, 52, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (766, 83, 9, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (767, 64, 15, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (768, 42, 18, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (769, 182, 33, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (770, 51, 25, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (771, 93, 50, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (772, 72, 48, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (773, 98, 37, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (774, 186, 31, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (775, 11, 30, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (776, 111, 8, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (777, 175, 4, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (778, 102, 24, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (779, 126, 44, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (780, 40, 29, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (781, 201, 24, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (782, 19, 12, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (783, 129, 35, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (784, 48, 16, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (785, 113, 4, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (786, 81, 50, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (787, 98, 32, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (788, 98, 35, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (789, 144, 36, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (790, 31, 41, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (791, 62, 16, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (792, 71, 51, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (793, 148, 43, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (794, 154, 24, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (795, 153, 30, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (796, 91, 33, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (797, 130, 23, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (798, 15, 10, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (799, 89, 43, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (800, 130, 44, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (801, 30, 16, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (802, 173, 28, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (803, 190, 13, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (804, 26, 4, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (805, 150, 35, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (806, 185, 37, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (807, 128, 9, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (808, 146, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (809, 189, 53, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (810, 42, 52, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (811, 64, 32, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (812, 202, 15, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (814, 51, 40, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (815, 197, 35, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (816, 51, 19, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (819, 15, 46, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (820, 24, 51, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (821, 94, 24, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (822, 142, 15, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (823, 20, 39, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (824, 64, 23, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (825, 4, 47, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (826, 72, 9, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (827, 103, 13, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (828, 84, 27, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (829, 36, 23, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (830, 90, 5, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (831, 164, 51, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (832, 64, 38, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (833, 118, 42, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (834, 29, 20, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (835, 53, 17, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (836, 121, 10, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (838, 141, 15, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (839, 18, 37, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (840, 39, 46, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (841, 144, 52, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (842, 157, 49, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (843, 56, 32, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (844, 10, 27, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (845, 55, 48, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (846, 133, 32, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (847, 190, 51, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (848, 184, 39, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (849, 162, 46, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (850, 186, 53, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (851, 42, 4, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (852, 99, 21, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (853, 64, 28, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (854, 105, 8, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (855, 181, 13, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (856, 62, 48, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (857, 112, 6, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (860, 23, 38, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (861, 34, 38, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (862, 6, 53, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (864, 197, 15, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (865, 53, 31, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (867, 180, 17, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (868, 142, 37, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (869, 52, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (870, 70, 5, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (871, 30, 33, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (872, 110, 49, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (873, 187, 48, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (874, 141, 42, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (875, 153, 26, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (877, 35, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (879, 26, 8, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (880, 34, 27, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (882, 129, 51, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (884, 122, 37, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (885, 115, 43, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (886, 53, 48, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (887, 149, 51, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (888, 112, 47, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (892, 85, 25, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (893, 186, 17, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (894, 124, 15, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (895, 63, 44, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (896, 37, 24, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (898, 124, 39, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (899, 144, 24, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (900, 186, 21, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (901, 200, 48, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (902, 106, 48, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (903, 177, 39, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (904, 184, 24, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (905, 66, 21, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (906, 169, 8, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (907, 194, 28, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (908, 122, 29, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (909, 152, 13, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (911, 98, 51, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (912, 63, 36, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (913, 80, 51, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (914, 125, 12, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (915, 16, 43, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (916, 154, 23, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (917, 147, 23, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (920, 97, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (921, 124, 24, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (922, 59, 10, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (923, 170, 42, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (924, 67, 19, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (925, 115, 47, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (926, 144, 47, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (927, 63, 46, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (928, 74, 14, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (929, 9, 28, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (930, 70, 15, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (931, 25, 47, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (932, 12, 34, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (933, 178, 31, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (934, 35, 10, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (936, 91, 6, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (938, 104, 12, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (939, 63, 4, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (940, 32, 38, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (941, 9, 7, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (942, 57, 10, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (943, 175, 48, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (944, 176, 36, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (945, 143, 29, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (946, 99, 46, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (947, 163, 26, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (948, 179, 4, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (949, 96, 16, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (950, 184, 41, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (951, 10, 49, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (952, 89, 25, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (953, 172, 51, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (954, 39, 7, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (955, 161, 41, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (956, 72, 29, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (957, 97, 46, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (958, 167, 31, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (959, 72, 5, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (961, 126, 50, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (962, 164, 15, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (963, 153, 6, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (964, 5, 48, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (965, 87, 25, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (966, 176, 5, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (967, 121, 45, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (969, 131, 26, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (970, 77, 27, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (971, 54, 46, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (972, 96, 42, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (973, 105, 13, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (974, 95, 39, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (975, 38, 40, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (976, 34, 31, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (977, 67, 49, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (979, 96, 47, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (980, 191, 8, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (981, 131, 50, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (982, 149, 46, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (983, 11, 37, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (984, 47, 8, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (985, 165, 41, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (986, 72, 26, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (987, 53, 47, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (988, 119, 19, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (989, 165, 9, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (990, 23, 12, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (991, 122, 25, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (994, 19, 17, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (995, 21, 36, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (996, 203, 5, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (997, 28, 31, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (998, 72, 8, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (999, 84, 41, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (1000, 109, 24, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (1001, 93, 4, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (1002, 144, 28, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (1003, 74, 18, '2025-08-23 10:46:22', 'approved');
-INSERT INTO `registrations` VALUES (1006, 2, 10, '2025-09-01 10:22:21', 'approved');
-
--- ----------------------------
--- Table structure for users
--- ----------------------------
-DROP TABLE IF EXISTS `users`;
-CREATE TABLE `users`  (
-  `id` int NOT NULL AUTO_INCREMENT,
-  `username` varchar(50) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '用户名/学号',
-  `password_hash` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '加密后的密码',
-  `full_name` varchar(50) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NULL DEFAULT NULL COMMENT '真实姓名',
-  `college` varchar(100) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NULL DEFAULT NULL COMMENT '所属学院',
-  `role` varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'student' COMMENT '角色 (student, admin)',
-  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP,
-  PRIMARY KEY (`id`) USING BTREE,
-  UNIQUE INDEX `username`(`username` ASC) USING BTREE
-) ENGINE = InnoDB AUTO_INCREMENT = 207 CHARACTER SET = utf8mb4 COLLATE = utf8mb4_unicode_ci COMMENT = '用户表' ROW_FORMAT = DYNAMIC;
-
--- ----------------------------
--- Records of users
--- ----------------------------
-INSERT INTO `users` VALUES (1, 'admin', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '管理员', '信息中心', 'admin', '2025-08-20 22:38:36');
-INSERT INTO `users` VALUES (2, 'student1', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '张三', '计算机学院', 'student', '2025-08-20 22:38:36');
-INSERT INTO `users` VALUES (3, 'student2', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '李四', '外国语学院', 'student', '2025-08-20 22:38:36');
-INSERT INTO `users` VALUES (4, 'student4', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学A4', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (5, 'student5', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学B5', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (6, 'student6', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学C6', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (7, 'student7', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学D7', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (8, 'student8', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学E8', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (9, 'student9', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学F9', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (10, 'student10', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学G10', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (11, 'student11', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学H11', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (12, 'student12', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学I12', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (13, 'student13', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学J13', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (14, 'student14', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学K14', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (15, 'student15', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学L15', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (16, 'student16', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学M16', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (17, 'student17', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学N17', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (18, 'student18', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学O18', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (19, 'student19', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学P19', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (20, 'student20', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学Q20', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (21, 'student21', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学R21', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (22, 'student22', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学S22', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (23, 'student23', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学T23', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (24, 'student24', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学U24', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (25, 'student25', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学V25', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (26, 'student26', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学W26', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (27, 'student27', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学X27', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (28, 'student28', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学Y28', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (29, 'student29', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学Z29', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (30, 'student30', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学A30', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (31, 'student31', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学B31', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (32, 'student32', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学C32', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (33, 'student33', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学D33', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (34, 'student34', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学E34', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (35, 'student35', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学F35', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (36, 'student36', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学G36', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (37, 'student37', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学H37', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (38, 'student38', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学I38', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (39, 'student39', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学J39', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (40, 'student40', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学K40', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (41, 'student41', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学L41', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (42, 'student42', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学M42', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (43, 'student43', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学N43', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (44, 'student44', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学O44', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (45, 'student45', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学P45', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (46, 'student46', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学Q46', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (47, 'student47', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学R47', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (48, 'student48', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学S48', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (49, 'student49', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学T49', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (50, 'student50', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学U50', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (51, 'student51', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学V51', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (52, 'student52', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学W52', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (53, 'student53', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学X53', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (54, 'student54', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学Y54', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (55, 'student55', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学Z55', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (56, 'student56', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学A56', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (57, 'student57', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学B57', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (58, 'student58', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学C58', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (59, 'student59', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学D59', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (60, 'student60', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学E60', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (61, 'student61', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学F61', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (62, 'student62', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学G62', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (63, 'student63', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学H63', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (64, 'student64', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学I64', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (65, 'student65', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学J65', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (66, 'student66', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学K66', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (67, 'student67', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学L67', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (68, 'student68', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学M68', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (69, 'student69', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学N69', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (70, 'student70', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学O70', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (71, 'student71', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学P71', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (72, 'student72', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学Q72', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (73, 'student73', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学R73', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (74, 'student74', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学S74', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (75, 'student75', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学T75', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (76, 'student76', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学U76', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (77, 'student77', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学V77', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (78, 'student78', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学W78', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (79, 'student79', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学X79', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (80, 'student80', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学Y80', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (81, 'student81', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学Z81', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (82, 'student82', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学A82', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (83, 'student83', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学B83', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (84, 'student84', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学C84', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (85, 'student85', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学D85', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (86, 'student86', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学E86', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (87, 'student87', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学F87', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (88, 'student88', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学G88', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (89, 'student89', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学H89', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (90, 'student90', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学I90', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (91, 'student91', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学J91', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (92, 'student92', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学K92', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (93, 'student93', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学L93', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (94, 'student94', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学M94', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (95, 'student95', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学N95', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (96, 'student96', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学O96', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (97, 'student97', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学P97', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (98, 'student98', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学Q98', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (99, 'student99', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学R99', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (100, 'student100', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学S100', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (101, 'student101', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学T101', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (102, 'student102', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学U102', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (103, 'student103', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学V103', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (104, 'student104', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学W104', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (105, 'student105', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学X105', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (106, 'student106', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学Y106', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (107, 'student107', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学Z107', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (108, 'student108', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学A108', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (109, 'student109', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学B109', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (110, 'student110', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学C110', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (111, 'student111', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学D111', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (112, 'student112', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学E112', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (113, 'student113', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学F113', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (114, 'student114', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学G114', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (115, 'student115', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学H115', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (116, 'student116', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学I116', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (117, 'student117', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学J117', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (118, 'student118', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学K118', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (119, 'student119', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学L119', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (120, 'student120', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学M120', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (121, 'student121', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学N121', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (122, 'student122', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学O122', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (123, 'student123', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学P123', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (124, 'student124', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学Q124', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (125, 'student125', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学R125', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (126, 'student126', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学S126', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (127, 'student127', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学T127', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (128, 'student128', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学U128', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (129, 'student129', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学V129', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (130, 'student130', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学W130', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (131, 'student131', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学X131', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (132, 'student132', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学Y132', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (133, 'student133', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学Z133', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (134, 'student134', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学A134', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (135, 'student135', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学B135', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (136, 'student136', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学C136', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (137, 'student137', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学D137', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (138, 'student138', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学E138', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (139, 'student139', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学F139', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (140, 'student140', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学G140', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (141, 'student141', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学H141', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (142, 'student142', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学I142', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (143, 'student143', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学J143', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (144, 'student144', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学K144', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (145, 'student145', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学L145', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (146, 'student146', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学M146', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (147, 'student147', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学N147', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (148, 'student148', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学O148', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (149, 'student149', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学P149', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (150, 'student150', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学Q150', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (151, 'student151', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学R151', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (152, 'student152', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学S152', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (153, 'student153', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学T153', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (154, 'student154', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学U154', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (155, 'student155', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学V155', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (156, 'student156', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学W156', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (157, 'student157', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学X157', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (158, 'student158', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学Y158', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (159, 'student159', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学Z159', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (160, 'student160', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学A160', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (161, 'student161', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学B161', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (162, 'student162', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学C162', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (163, 'student163', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学D163', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (164, 'student164', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学E164', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (165, 'student165', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学F165', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (166, 'student166', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学G166', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (167, 'student167', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学H167', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (168, 'student168', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学I168', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (169, 'student169', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学J169', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (170, 'student170', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学K170', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (171, 'student171', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学L171', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (172, 'student172', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学M172', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (173, 'student173', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学N173', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (174, 'student174', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学O174', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (175, 'student175', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学P175', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (176, 'student176', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学Q176', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (177, 'student177', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学R177', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (178, 'student178', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学S178', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (179, 'student179', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学T179', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (180, 'student180', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学U180', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (181, 'student181', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学V181', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (182, 'student182', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学W182', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (183, 'student183', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学X183', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (184, 'student184', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学Y184', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (185, 'student185', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学Z185', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (186, 'student186', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学A186', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (187, 'student187', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学B187', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (188, 'student188', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学C188', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (189, 'student189', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学D189', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (190, 'student190', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学E190', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (191, 'student191', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学F191', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (192, 'student192', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学G192', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (193, 'student193', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学H193', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (194, 'student194', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学I194', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (195, 'student195', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学J195', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (196, 'student196', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学K196', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (197, 'student197', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学L197', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (198, 'student198', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学M198', '外国语学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (199, 'student199', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学N199', '材料科学与工程学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (200, 'student200', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学O200', '艺术与设计学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (201, 'student201', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学P201', '理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (202, 'student202', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学Q202', '计算机科学与技术学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (203, 'student203', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '测试同学R203', '经济管理学院', 'student', '2025-08-23 10:46:21');
-INSERT INTO `users` VALUES (205, 'admin1', '$2a$10$vmR.25oRY4fT/LYaSkyZHetLV8.68eTN5Q7S7E6laU.vyDWEQvAwa', '', '', 'admin', '2025-09-01 10:09:21');
-INSERT INTO `users` VALUES (206, 'student1000', '$2a$10$mBArMC9ozBwW/ENvdWgZyOrSvVx4F6Lt7t5rAtH1saIkgwaisq3Yi', 'some Awe', '计算机学院', 'student', '2025-09-01 10:24:36');
-
-SET FOREIGN_KEY_CHECKS = 1;
@@ -1,11 +1,24 @@
 package main
 
 import (
+	"campus-activity-api/internal/auth"
 	"campus-activity-api/internal/config"
-	"campus-activity-api/internal/database"
 	"campus-activity-api/internal/handlers"
+	"campus-activity-api/internal/health"
+	"campus-activity-api/internal/logging"
+	"campus-activity-api/internal/loginguard"
 	"campus-activity-api/internal/middleware"
+	"campus-activity-api/internal/models"
+	"context"
+	"errors"
+	"flag"
 	"log"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/gin-contrib/cors"
@@ -13,60 +26,246 @@ import (
 )
 
 func main() {
-	// 1. 加载配置
-	if err := config.LoadConfig(); err != nil {
+	// 1. 加载配置：配置文件 → 环境变量 → 命令行参数
+	args, err := config.LoadConfig(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
 		log.Fatalf("无法加载配置: %v", err)
 	}
 
-	// 2. 初始化数据库连接
-	db, err := database.InitDB()
+	// 数据库迁移子命令：main [配置参数] migrate up|down|status|create|baseline|seed
+	if len(args) > 0 && args[0] == "migrate" {
+		runMigrate(args[1:])
+		return
+	}
+	if err := config.Cfg.Validate(); err != nil {
+		log.Fatalf("配置无效: %v", err)
+	}
+	// 结构化日志，标准库 log 的输出也会经由它写出
+	logger, err := logging.New(os.Stdout, config.Cfg.Log.Level, config.Cfg.Log.Format)
+	if err != nil {
+		log.Fatalf("配置无效: %v", err)
+	}
+	slog.SetDefault(logger)
+
+	// 2. 初始化数据库连接，默认使用 MySQL，使用 sqlite 构建标签时使用 SQLite
+	st, db, err := openStore()
+	if err != nil {
+		fatal("无法初始化数据库", err)
+	}
+	slog.Info("数据库连接成功")
+
+	// 3. 组装 handler 的依赖
+
+	// 登录防暴力破解，单实例部署使用进程内存储
+	guard := loginguard.New(loginguard.NewMemoryStore(),
+		loginguard.Policy{
+			MaxFailures:     config.Cfg.Login.MaxFailures,
+			LockoutDuration: time.Duration(config.Cfg.Login.LockoutMinutes) * time.Minute,
+			BaseDelay:       time.Duration(config.Cfg.Login.BaseDelaySeconds) * time.Second,
+			MaxDelay:        time.Duration(config.Cfg.Login.MaxDelaySeconds) * time.Second,
+		},
+		loginIPPolicy(config.Cfg),
+	)
+	if len(config.Cfg.Server.TrustedProxies) == 0 {
+		slog.Warn("未配置 server.trustedProxies，部署在反向代理后面时所有请求共用一个 IP，登录限制的 IP 策略只做退避、不锁定")
+	}
+
+	// 初始化 token 签发器，加载 access token 吊销列表
+	tokens, err := auth.NewManager(config.Cfg.JWT)
 	if err != nil {
-		log.Fatalf("无法初始化数据库: %v", err)
+		fatal("无法初始化 JWT 密钥", err)
+	}
+	revocations := auth.NewRevocationList(st.Sessions())
+	if err := revocations.Sync(context.Background()); err != nil {
+		fatal("无法加载 token 吊销列表", err)
 	}
-	defer db.Close()
-	
-	// 3. 将数据库连接实例注入到handlers包
-	handlers.DB = db
-	log.Println("数据库连接成功!")
 
-	// 4. Gin 路由
-	router := gin.Default()
+	// 就绪检查：数据库连通，迁移已执行到最新
+	readiness := health.NewReadiness(readinessTimeout)
+	readiness.Add("database", db.PingContext)
+	check, err := migrationCheck(db)
+	if err != nil {
+		fatal("加载迁移文件失败", err)
+	}
+	if check != nil {
+		readiness.Add("migrations", check)
+	}
+
+	h := &handlers.Handler{Store: st, Tokens: tokens, Revocations: revocations, LoginGuard: guard, Readiness: readiness}
+
+	// 4. 启动后台任务：定期同步 token 吊销列表
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	var workers sync.WaitGroup
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		revocations.Run(workerCtx)
+	}()
+
+	// 5. 启动 HTTP 服务，显式设置超时，避免慢客户端长期占用连接
+	router, err := setupRouter(h, tokens, revocations, logger)
+	if err != nil {
+		fatal("无法初始化路由", err)
+	}
+	srv := &http.Server{
+		Addr:              config.Cfg.Server.Addr,
+		Handler:           router,
+		ReadTimeout:       time.Duration(config.Cfg.Server.ReadTimeoutSeconds) * time.Second,
+		ReadHeaderTimeout: time.Duration(config.Cfg.Server.ReadHeaderTimeoutSeconds) * time.Second,
+		WriteTimeout:      time.Duration(config.Cfg.Server.WriteTimeoutSeconds) * time.Second,
+		IdleTimeout:       time.Duration(config.Cfg.Server.IdleTimeoutSeconds) * time.Second,
+		ErrorLog:          slog.NewLogLogger(logger.Handler(), slog.LevelWarn),
+	}
+	serveErr := make(chan error, 1)
+	go func() {
+		slog.Info("HTTP 服务开始监听", "addr", srv.Addr)
+		serveErr <- srv.ListenAndServe()
+	}()
+
+	// 6. 等待 SIGINT/SIGTERM 或服务异常退出
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	exitCode := 0
+	select {
+	case err := <-serveErr:
+		slog.Error("HTTP 服务异常退出", "error", err)
+		exitCode = 1
+	case <-ctx.Done():
+		slog.Info("收到退出信号，开始关闭服务")
+	}
+	// 再次收到信号时按默认行为立即退出
+	stop()
+
+	// 7. 就绪检查改为失败，可选地等待负载均衡摘除本实例
+	readiness.SetDraining()
+	if delay := config.Cfg.Server.DrainDelaySeconds; delay > 0 {
+		// 就绪检查失败后继续处理请求一段时间，等负载均衡把实例摘除
+		slog.Info("就绪检查已标记为失败，稍后停止接收新请求", "delay_seconds", delay)
+		time.Sleep(time.Duration(delay) * time.Second)
+	}
+
+	// 8. 按顺序关闭：停止接收新请求并等待处理中的请求完成 → 停止后台任务 → 关闭数据库连接
+	shutdownCtx, cancel := context.WithTimeout(context.Background(),
+		time.Duration(config.Cfg.Server.ShutdownTimeoutSeconds)*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		// 超时后仍未完成的请求被强制断开，其中未提交的事务由数据库回滚
+		slog.Warn("等待处理中的请求超时，强制关闭", "error", err)
+		srv.Close()
+		exitCode = 1
+	}
+	stopWorkers()
+	workers.Wait()
+	if err := db.Close(); err != nil {
+		slog.Error("关闭数据库连接失败", "error", err)
+		exitCode = 1
+	}
+	slog.Info("服务已关闭")
+	os.Exit(exitCode)
+}
+
+// 登录限制的 IP 策略
+// 配置了可信代理时能取到真实的客户端 IP，达到次数上限后锁定，不做逐次退避，避免同一出口下的正常用户互相影响；
+// 未配置时部署在反向代理后面的所有请求都来自代理地址、共用一个计数，锁定会让所有人都无法登录，
+// 因此超过次数上限后只做有上限的退避，不锁定
+func loginIPPolicy(c *config.Config) loginguard.Policy {
+	lockout := time.Duration(c.Login.LockoutMinutes) * time.Minute
+	if len(c.Server.TrustedProxies) > 0 {
+		return loginguard.Policy{MaxFailures: c.Login.IPMaxFailures, LockoutDuration: lockout}
+	}
+	return loginguard.Policy{
+		LockoutDuration: lockout,
+		FreeFailures:    c.Login.IPMaxFailures,
+		BaseDelay:       time.Duration(c.Login.BaseDelaySeconds) * time.Second,
+		MaxDelay:        time.Duration(c.Login.MaxDelaySeconds) * time.Second,
+	}
+}
+
+// 记录错误并退出
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
+}
+
+// 每次就绪检查（数据库 ping 和迁移查询）的超时
+const readinessTimeout = 2 * time.Second
+
+// 注册中间件和所有路由，测试使用同一套路由
+func setupRouter(h *handlers.Handler, tokens *auth.Manager, revocations *auth.RevocationList, logger *slog.Logger) (*gin.Engine, error) {
+	router := gin.New()
+	// gin 默认信任所有代理，客户端可以伪造 X-Forwarded-For 绕过按 IP 的登录限制，这里只信任配置的代理
+	if err := router.SetTrustedProxies(config.Cfg.Server.TrustedProxies); err != nil {
+		return nil, err
+	}
+	// 请求日志在最外层，panic 恢复在其后，这样 panic 也会以 500 记录在请求日志中
+	router.Use(middleware.RequestLogger(logger), middleware.Recovery())
 	router.Use(cors.New(cors.Config{
-		AllowOrigins:     []string{"http://localhost:5173", "https://jinjie1101.z23.web.core.windows.net"},
-		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization"},
-		ExposeHeaders:    []string{"Authorization"},
+		AllowOrigins:     config.Cfg.Server.CORSOrigins,
+		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization", middleware.RequestIDHeader},
+		ExposeHeaders:    []string{"Authorization", middleware.RequestIDHeader},
 		AllowCredentials: true,
 		// 对于相同的请求，无需再发送 OPTIONS 预检请求
 		MaxAge: 12 * time.Hour,
 	}))
 
+	// 鉴权中间件组合：登录校验 + 角色校验
+	requireAuth := middleware.AuthMiddleware(tokens, revocations)
+	anyUser := middleware.RequireRoles(models.RoleStudent, models.RoleOrganizer, models.RoleAdmin)
+	organizerOrAdmin := middleware.RequireRoles(models.RoleOrganizer, models.RoleAdmin)
+	adminOnly := middleware.RequireRoles(models.RoleAdmin)
+
+	// 容器平台的存活、就绪探测和构建信息
+	router.GET("/healthz", h.Healthz)
+	router.GET("/readyz", h.Readyz)
+	router.GET("/version", h.Version)
+
 	api := router.Group("/api")
 	{
 		// auth
-		api.POST("/register", handlers.Register) // 注册
-		api.POST("/login", handlers.Login)       // 登录
+		api.POST("/register", h.Register) // 注册
+		api.POST("/login", h.Login)       // 登录
+		api.POST("/token/refresh", h.RefreshToken)
+		api.POST("/logout", requireAuth, h.Logout)
+		api.POST("/me/password", requireAuth, anyUser, h.ChangePassword)
+		api.POST("/password/reset", h.ResetPassword)
 		// user
-		api.GET("/users/:id/registrations", handlers.GetMyActivities)
-		api.POST("/activities/:id/register", middleware.AuthMiddleware(), handlers.RegisterForActivityHandler(db))
-		api.DELETE("/registrations/:id", handlers.CancelRegistration)
+		api.GET("/users/:id/registrations", requireAuth, anyUser, h.GetMyActivities)
+		api.POST("/activities/:id/register", requireAuth, anyUser, h.RegisterForActivity)
+		api.DELETE("/registrations/:id", requireAuth, anyUser, h.CancelRegistration)
+		api.GET("/registrations/:id/history", requireAuth, anyUser, h.GetRegistrationHistory)
+		// waitlist
+		api.POST("/activities/:id/waitlist", requireAuth, anyUser, h.JoinWaitlist)
+		api.GET("/activities/:id/waitlist/position", requireAuth, anyUser, h.GetWaitlistPosition)
+		// check-in
+		api.GET("/registrations/:id/qrcode", requireAuth, anyUser, h.GetCheckinQRCode)
+		api.POST("/activities/:id/checkin", requireAuth, organizerOrAdmin, h.Checkin)
 		// activity
-		api.GET("/activities", handlers.GetActivities)
-		api.GET("/activities/:id", handlers.GetActivityByID)
-		api.POST("/activities", middleware.AuthMiddleware(), handlers.CreateActivity)
-		api.DELETE("/activities/:id", handlers.DeleteActivity)
+		api.GET("/activities", middleware.OptionalAuthMiddleware(tokens, revocations), h.GetActivities)
+		api.GET("/activities/:id", h.GetActivityByID)
+		api.POST("/activities", requireAuth, organizerOrAdmin, h.CreateActivity)
+		api.PATCH("/activities/:id", requireAuth, organizerOrAdmin, h.UpdateActivity)
+		api.DELETE("/activities/:id", requireAuth, organizerOrAdmin, h.DeleteActivity)
 		// stats
-		api.GET("/stats/hot-activities", handlers.GetHotActivities)
-		api.GET("/stats/organizer-activity-counts", handlers.GetOrganizerStats)
+		api.GET("/stats/hot-activities", h.GetHotActivities)
+		api.GET("/stats/organizer-activity-counts", h.GetOrganizerStats)
 		// admin
-		api.GET("/activities/:id/registrations", handlers.GetRegistrationsByActivityIDHandler(db))
-		admin := api.Group("/admin")
+		api.GET("/activities/:id/registrations", requireAuth, organizerOrAdmin, h.GetRegistrationsByActivityID)
+		api.GET("/activities/:id/registrations/export", requireAuth, organizerOrAdmin, h.ExportRegistrations)
+		admin := api.Group("/admin", requireAuth, adminOnly)
 		{
-			admin.GET("/registrations", handlers.GetRegistrationsHandler(db))
-			admin.PUT("/registrations/:registrationId/status", handlers.AdminUpdateRegistrationStatusHandler(db))
-			admin.DELETE("/registrations/:id", middleware.AuthMiddleware(), handlers.AdminDeleteRegistrationHandler(db))
+			admin.GET("/registrations", h.GetRegistrations)
+			admin.PUT("/registrations/:registrationId/status", h.AdminUpdateRegistrationStatus)
+			admin.POST("/registrations/bulk-status", h.AdminBulkUpdateRegistrationStatus)
+			admin.DELETE("/registrations/:id", h.AdminDeleteRegistration)
+			admin.POST("/users/import", h.ImportUsers)
+			admin.POST("/users/:id/revoke-sessions", h.AdminRevokeUserSessions)
+			admin.POST("/users/:id/password-reset", h.AdminIssuePasswordReset)
+			admin.POST("/users/:id/unlock", h.AdminUnlockLogin)
 		}
 	}
-
-	router.Run(":8080")
+	return router, nil
 }
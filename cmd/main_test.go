@@ -0,0 +1,757 @@
+package main
+
+import (
+	"bytes"
+	"campus-activity-api/internal/auth"
+	"campus-activity-api/internal/config"
+	"campus-activity-api/internal/handlers"
+	"campus-activity-api/internal/health"
+	"campus-activity-api/internal/models"
+	"campus-activity-api/internal/store"
+	"campus-activity-api/internal/store/sqlite"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 测试环境：内存 SQLite + 与 main 相同的路由
+type testEnv struct {
+	t         *testing.T
+	server    *httptest.Server
+	store     store.Store
+	readiness *health.Readiness
+	logs      *logBuffer // 请求日志，JSON 格式，每行一条
+}
+
+// 服务端 goroutine 写入、测试读取的日志缓冲区
+type logBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *logBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *logBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	config.Cfg = &config.Config{
+		Server: config.ServerConfig{CORSOrigins: []string{"http://localhost:5173"}},
+		JWT: config.JWTConfig{
+			Keys:               []config.JWTKey{{ID: "test", Secret: "test-secret"}},
+			ActiveKeyID:        "test",
+			Issuer:             "campus-activity-api",
+			Audience:           "campus-activity-web",
+			AccessTokenMinutes: 15,
+			RefreshTokenDays:   14,
+		},
+		Checkin: config.CheckinConfig{OpenBeforeMinutes: 30, CloseAfterMinutes: 30},
+	}
+
+	db, err := sqlite.Open(context.Background(), ":memory:")
+	if err != nil {
+		t.Fatalf("打开 SQLite 失败: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	st := store.NewSQLite(db)
+
+	tokens, err := auth.NewManager(config.Cfg.JWT)
+	if err != nil {
+		t.Fatalf("初始化 token 签发器失败: %v", err)
+	}
+	revocations := auth.NewRevocationList(st.Sessions())
+	readiness := health.NewReadiness(time.Second)
+	readiness.Add("database", db.PingContext)
+	h := &handlers.Handler{Store: st, Tokens: tokens, Revocations: revocations, Readiness: readiness}
+
+	logs := &logBuffer{}
+	logger := slog.New(slog.NewJSONHandler(logs, nil))
+	router, err := setupRouter(h, tokens, revocations, logger)
+	if err != nil {
+		t.Fatalf("初始化路由失败: %v", err)
+	}
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	return &testEnv{t: t, server: server, store: st, readiness: readiness, logs: logs}
+}
+
+// 直接写入数据库创建用户，用于创建无法自助注册的组织者和管理员
+func (e *testEnv) createUser(username, password, role string) models.User {
+	e.t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		e.t.Fatal(err)
+	}
+	user := models.User{Username: username, PasswordHash: string(hash), FullName: username, Role: role}
+	if err := e.store.Users().Create(context.Background(), &user); err != nil {
+		e.t.Fatalf("创建用户 %s 失败: %v", username, err)
+	}
+	return user
+}
+
+// 发送请求，body 不为 nil 时编码为 JSON；响应体解码到 out（可为 nil），返回状态码
+func (e *testEnv) do(method, path, token string, body, out interface{}) int {
+	e.t.Helper()
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			e.t.Fatal(err)
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, e.server.URL+path, reader)
+	if err != nil {
+		e.t.Fatal(err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := e.server.Client().Do(req)
+	if err != nil {
+		e.t.Fatalf("%s %s 失败: %v", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		e.t.Fatal(err)
+	}
+	if out != nil {
+		if err := json.Unmarshal(data, out); err != nil {
+			e.t.Fatalf("%s %s 响应无法解析: %v\n%s", method, path, err, data)
+		}
+	}
+	return resp.StatusCode
+}
+
+// 登录并返回用户 ID 和 access token
+func (e *testEnv) login(username, password string) (int, string) {
+	e.t.Helper()
+	var resp struct {
+		Token string `json:"token"`
+		User  struct {
+			ID int `json:"id"`
+		} `json:"user"`
+	}
+	if code := e.do(http.MethodPost, "/api/login", "", gin.H{"username": username, "password": password}, &resp); code != http.StatusOK {
+		e.t.Fatalf("登录 %s 返回 %d", username, code)
+	}
+	return resp.User.ID, resp.Token
+}
+
+// 创建一个明天开始的活动，返回活动 ID
+func (e *testEnv) createActivity(token string, capacity int) int {
+	e.t.Helper()
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	var activity models.Activity
+	code := e.do(http.MethodPost, "/api/activities", token, gin.H{
+		"title":     "数据库课程设计讲座",
+		"organizer": "计算机学院",
+		"location":  "教3-201",
+		"startTime": start,
+		"endTime":   start.Add(2 * time.Hour),
+		"capacity":  capacity,
+	}, &activity)
+	if code != http.StatusCreated {
+		e.t.Fatalf("创建活动返回 %d", code)
+	}
+	return activity.ID
+}
+
+// 查询用户在某活动下的报名记录
+func (e *testEnv) myRegistration(userID int, token string, activityID int) models.UserRegistration {
+	e.t.Helper()
+	var list []models.UserRegistration
+	if code := e.do(http.MethodGet, "/api/users/"+strconv.Itoa(userID)+"/registrations", token, nil, &list); code != http.StatusOK {
+		e.t.Fatalf("查询我的活动返回 %d", code)
+	}
+	for _, r := range list {
+		if r.ActivityID == activityID {
+			return r
+		}
+	}
+	e.t.Fatalf("用户 %d 没有活动 %d 的报名记录", userID, activityID)
+	return models.UserRegistration{}
+}
+
+// 注册 → 登录 → 创建活动 → 报名 → 审核通过 → 取消报名
+func TestRegistrationLifecycle(t *testing.T) {
+	env := newTestEnv(t)
+	env.createUser("admin", "admin123", models.RoleAdmin)
+
+	code := env.do(http.MethodPost, "/api/register", "", gin.H{
+		"username": "20230001", "password": "secret123", "fullName": "张三", "college": "计算机学院",
+	}, nil)
+	if code != http.StatusCreated {
+		t.Fatalf("注册返回 %d, 期望 %d", code, http.StatusCreated)
+	}
+	studentID, studentToken := env.login("20230001", "secret123")
+	_, adminToken := env.login("admin", "admin123")
+
+	activityID := env.createActivity(adminToken, 10)
+
+	var registered struct {
+		Status string `json:"status"`
+	}
+	code = env.do(http.MethodPost, "/api/activities/"+strconv.Itoa(activityID)+"/register", studentToken, nil, &registered)
+	if code != http.StatusCreated || registered.Status != models.RegistrationPending {
+		t.Fatalf("报名返回 %d, 状态 %q, 期望 %d, %q", code, registered.Status, http.StatusCreated, models.RegistrationPending)
+	}
+	reg := env.myRegistration(studentID, studentToken, activityID)
+	regPath := "/api/registrations/" + strconv.Itoa(reg.RegistrationID)
+
+	code = env.do(http.MethodPut, "/api/admin/registrations/"+strconv.Itoa(reg.RegistrationID)+"/status", adminToken,
+		gin.H{"status": models.RegistrationApproved}, nil)
+	if code != http.StatusOK {
+		t.Fatalf("审核通过返回 %d", code)
+	}
+	if got := env.myRegistration(studentID, studentToken, activityID).Status; got != models.RegistrationApproved {
+		t.Fatalf("审核后状态为 %q, 期望 %q", got, models.RegistrationApproved)
+	}
+
+	if code := env.do(http.MethodDelete, regPath, studentToken, nil, nil); code != http.StatusOK {
+		t.Fatalf("取消报名返回 %d", code)
+	}
+	if got := env.myRegistration(studentID, studentToken, activityID).Status; got != models.RegistrationCancelled {
+		t.Fatalf("取消后状态为 %q, 期望 %q", got, models.RegistrationCancelled)
+	}
+
+	// 状态历史按时间顺序记录了每一次变更
+	var history []models.RegistrationStatusChange
+	if code := env.do(http.MethodGet, regPath+"/history", studentToken, nil, &history); code != http.StatusOK {
+		t.Fatalf("查询状态历史返回 %d", code)
+	}
+	want := []string{models.RegistrationPending, models.RegistrationApproved, models.RegistrationCancelled}
+	if len(history) != len(want) {
+		t.Fatalf("状态历史有 %d 条, 期望 %d 条", len(history), len(want))
+	}
+	for i, change := range history {
+		if change.ToStatus != want[i] {
+			t.Errorf("第 %d 条状态历史为 %q, 期望 %q", i+1, change.ToStatus, want[i])
+		}
+	}
+}
+
+func TestRegisterForFullActivity(t *testing.T) {
+	env := newTestEnv(t)
+	env.createUser("organizer", "organizer123", models.RoleOrganizer)
+	env.createUser("student1", "secret123", models.RoleStudent)
+	env.createUser("student2", "secret123", models.RoleStudent)
+	_, organizerToken := env.login("organizer", "organizer123")
+	_, token1 := env.login("student1", "secret123")
+	_, token2 := env.login("student2", "secret123")
+
+	activityID := env.createActivity(organizerToken, 1)
+	path := "/api/activities/" + strconv.Itoa(activityID) + "/register"
+	if code := env.do(http.MethodPost, path, token1, nil, nil); code != http.StatusCreated {
+		t.Fatalf("第一个报名返回 %d", code)
+	}
+
+	// 重复报名
+	if code := env.do(http.MethodPost, path, token1, nil, nil); code != http.StatusConflict {
+		t.Errorf("重复报名返回 %d, 期望 %d", code, http.StatusConflict)
+	}
+
+	// 待审核的报名同样占用名额
+	var resp struct {
+		Code string `json:"code"`
+	}
+	if code := env.do(http.MethodPost, path, token2, nil, &resp); code != http.StatusConflict || resp.Code != "ACTIVITY_FULL" {
+		t.Errorf("活动已满时报名返回 %d, %q, 期望 %d, %q", code, resp.Code, http.StatusConflict, "ACTIVITY_FULL")
+	}
+}
+
+func TestCreateActivityRequiresOrganizer(t *testing.T) {
+	env := newTestEnv(t)
+	env.createUser("student1", "secret123", models.RoleStudent)
+	_, token := env.login("student1", "secret123")
+
+	body := gin.H{"title": "未授权的活动", "organizer": "学生会", "startTime": time.Now(), "endTime": time.Now().Add(time.Hour)}
+	if code := env.do(http.MethodPost, "/api/activities", "", body, nil); code != http.StatusUnauthorized {
+		t.Errorf("未登录创建活动返回 %d, 期望 %d", code, http.StatusUnauthorized)
+	}
+	if code := env.do(http.MethodPost, "/api/activities", token, body, nil); code != http.StatusForbidden {
+		t.Errorf("学生创建活动返回 %d, 期望 %d", code, http.StatusForbidden)
+	}
+}
+
+func TestHealthEndpoints(t *testing.T) {
+	env := newTestEnv(t)
+
+	if code := env.do(http.MethodGet, "/healthz", "", nil, nil); code != http.StatusOK {
+		t.Errorf("存活检查返回 %d", code)
+	}
+	var version struct {
+		Commit    string `json:"commit"`
+		GoVersion string `json:"goVersion"`
+	}
+	if code := env.do(http.MethodGet, "/version", "", nil, &version); code != http.StatusOK || version.Commit == "" || version.GoVersion == "" {
+		t.Errorf("构建信息返回 %d, %+v", code, version)
+	}
+	if code := env.do(http.MethodGet, "/readyz", "", nil, nil); code != http.StatusOK {
+		t.Errorf("就绪检查返回 %d, 期望 %d", code, http.StatusOK)
+	}
+
+	// 服务停止过程中就绪检查失败，存活检查不受影响
+	env.readiness.SetDraining()
+	var resp struct {
+		Code string `json:"code"`
+	}
+	if code := env.do(http.MethodGet, "/readyz", "", nil, &resp); code != http.StatusServiceUnavailable || resp.Code != "NOT_READY" {
+		t.Errorf("停止中就绪检查返回 %d, %q, 期望 %d, %q", code, resp.Code, http.StatusServiceUnavailable, "NOT_READY")
+	}
+	if code := env.do(http.MethodGet, "/healthz", "", nil, nil); code != http.StatusOK {
+		t.Errorf("停止中存活检查返回 %d", code)
+	}
+}
+
+func TestRequestLogging(t *testing.T) {
+	env := newTestEnv(t)
+	env.createUser("student1", "secret123", models.RoleStudent)
+	userID, token := env.login("student1", "secret123")
+
+	req, err := http.NewRequest(http.MethodGet, env.server.URL+"/api/users/"+strconv.Itoa(userID)+"/registrations", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("X-Request-ID", "test-request-1")
+	req.Header.Set("X-Forwarded-For", "203.0.113.7") // 未配置可信代理，不应被采用
+	resp, err := env.server.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	// 沿用客户端传入的请求 ID
+	if got := resp.Header.Get("X-Request-ID"); got != "test-request-1" {
+		t.Errorf("响应头 X-Request-ID 为 %q, 期望 %q", got, "test-request-1")
+	}
+
+	var entry struct {
+		Msg       string `json:"msg"`
+		RequestID string `json:"request_id"`
+		Method    string `json:"method"`
+		Route     string `json:"route"`
+		Status    int    `json:"status"`
+		UserID    int    `json:"user_id"`
+		Role      string `json:"role"`
+		ClientIP  string `json:"client_ip"`
+	}
+	found := false
+	for _, line := range strings.Split(strings.TrimSpace(env.logs.String()), "\n") {
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("日志不是 JSON: %v\n%s", err, line)
+		}
+		if entry.Msg == "request" && entry.RequestID == "test-request-1" {
+			found = true
+			break
+		}
+	}
+	if !found {
+		t.Fatalf("没有找到请求 ID 为 test-request-1 的请求日志:\n%s", env.logs.String())
+	}
+	if entry.Method != http.MethodGet || entry.Route != "/api/users/:id/registrations" || entry.Status != http.StatusOK ||
+		entry.UserID != userID || entry.Role != models.RoleStudent || entry.ClientIP != "127.0.0.1" {
+		t.Errorf("请求日志内容不符: %+v", entry)
+	}
+
+	// 未传入时生成新的请求 ID
+	resp, err = env.server.Client().Get(env.server.URL + "/healthz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if got := resp.Header.Get("X-Request-ID"); got == "" || got == "test-request-1" {
+		t.Errorf("未传入请求 ID 时响应头为 %q, 期望新生成的 ID", got)
+	}
+}
+
+// 管理员把报名改为候补后，释放的名额递补给候补名单中的其他人，而不是该报名本身
+func TestDemoteToWaitlistPromotesNext(t *testing.T) {
+	env := newTestEnv(t)
+	env.createUser("admin", "admin123", models.RoleAdmin)
+	env.createUser("student1", "secret123", models.RoleStudent)
+	env.createUser("student2", "secret123", models.RoleStudent)
+	_, adminToken := env.login("admin", "admin123")
+	id1, token1 := env.login("student1", "secret123")
+	id2, token2 := env.login("student2", "secret123")
+
+	activityID := env.createActivity(adminToken, 1)
+	activityPath := "/api/activities/" + strconv.Itoa(activityID)
+	if code := env.do(http.MethodPost, activityPath+"/register", token1, nil, nil); code != http.StatusCreated {
+		t.Fatalf("报名返回 %d", code)
+	}
+	if code := env.do(http.MethodPost, activityPath+"/waitlist", token2, nil, nil); code != http.StatusCreated {
+		t.Fatalf("加入候补名单返回 %d", code)
+	}
+
+	reg1 := env.myRegistration(id1, token1, activityID)
+	code := env.do(http.MethodPut, "/api/admin/registrations/"+strconv.Itoa(reg1.RegistrationID)+"/status", adminToken,
+		gin.H{"status": models.RegistrationWaitlisted}, nil)
+	if code != http.StatusOK {
+		t.Fatalf("改为候补返回 %d", code)
+	}
+
+	if got := env.myRegistration(id1, token1, activityID).Status; got != models.RegistrationWaitlisted {
+		t.Errorf("被改为候补的报名状态为 %q, 期望 %q", got, models.RegistrationWaitlisted)
+	}
+	if got := env.myRegistration(id2, token2, activityID).Status; got != models.RegistrationPending {
+		t.Errorf("候补名单中的报名状态为 %q, 期望递补为 %q", got, models.RegistrationPending)
+	}
+}
+
+// 超过 bcrypt 上限的新密码返回 400 而不是 500
+func TestChangePasswordTooLong(t *testing.T) {
+	env := newTestEnv(t)
+	env.createUser("student1", "secret123", models.RoleStudent)
+	_, token := env.login("student1", "secret123")
+
+	body := gin.H{"oldPassword": "secret123", "newPassword": strings.Repeat("a", 73)}
+	if code := env.do(http.MethodPost, "/api/me/password", token, body, nil); code != http.StatusBadRequest {
+		t.Errorf("过长的新密码返回 %d, 期望 %d", code, http.StatusBadRequest)
+	}
+}
+
+// 修改活动时 null 清空时间字段，未传的字段保持不变
+func TestUpdateActivityClearsDeadline(t *testing.T) {
+	env := newTestEnv(t)
+	env.createUser("organizer", "organizer123", models.RoleOrganizer)
+	_, token := env.login("organizer", "organizer123")
+	activityID := env.createActivity(token, 10)
+	path := "/api/activities/" + strconv.Itoa(activityID)
+
+	deadline := time.Now().Add(12 * time.Hour).Truncate(time.Second)
+	var activity models.Activity
+	if code := env.do(http.MethodPatch, path, token, gin.H{"cancellationDeadline": deadline}, &activity); code != http.StatusOK {
+		t.Fatalf("设置取消截止时间返回 %d", code)
+	}
+	if activity.CancellationDeadline == nil {
+		t.Fatal("取消截止时间未设置")
+	}
+
+	// 只修改标题，取消截止时间保持不变
+	activity = models.Activity{}
+	if code := env.do(http.MethodPatch, path, token, gin.H{"title": "改名后的讲座"}, &activity); code != http.StatusOK {
+		t.Fatalf("修改标题返回 %d", code)
+	}
+	if activity.CancellationDeadline == nil {
+		t.Error("未传取消截止时间时不应清空")
+	}
+
+	activity = models.Activity{}
+	if code := env.do(http.MethodPatch, path, token, gin.H{"cancellationDeadline": nil}, &activity); code != http.StatusOK {
+		t.Fatalf("清空取消截止时间返回 %d", code)
+	}
+	if activity.CancellationDeadline != nil {
+		t.Errorf("取消截止时间为 %v, 期望已清空", activity.CancellationDeadline)
+	}
+}
+
+// 调低容量后待审核的报名多于剩余名额，批量审核通过的人数不能超过容量
+func TestApproveRespectsLoweredCapacity(t *testing.T) {
+	env := newTestEnv(t)
+	env.createUser("admin", "admin123", models.RoleAdmin)
+	_, adminToken := env.login("admin", "admin123")
+	activityID := env.createActivity(adminToken, 10)
+	activityPath := "/api/activities/" + strconv.Itoa(activityID)
+
+	var ids []int
+	for i := 1; i <= 10; i++ {
+		username := "student" + strconv.Itoa(i)
+		env.createUser(username, "secret123", models.RoleStudent)
+		userID, token := env.login(username, "secret123")
+		if code := env.do(http.MethodPost, activityPath+"/register", token, nil, nil); code != http.StatusCreated {
+			t.Fatalf("%s 报名返回 %d", username, code)
+		}
+		ids = append(ids, env.myRegistration(userID, token, activityID).RegistrationID)
+	}
+
+	var bulk struct {
+		Updated int                       `json:"updated"`
+		Failed  int                       `json:"failed"`
+		Results []models.BulkStatusResult `json:"results"`
+	}
+	code := env.do(http.MethodPost, "/api/admin/registrations/bulk-status", adminToken,
+		gin.H{"ids": ids[:5], "status": models.RegistrationApproved}, &bulk)
+	if code != http.StatusOK || bulk.Updated != 5 {
+		t.Fatalf("审核通过前 5 个报名返回 %d, 通过 %d 个", code, bulk.Updated)
+	}
+
+	if code := env.do(http.MethodPatch, activityPath, adminToken, gin.H{"capacity": 6}, nil); code != http.StatusOK {
+		t.Fatalf("把容量调低到 6 返回 %d", code)
+	}
+
+	bulk.Results = nil
+	code = env.do(http.MethodPost, "/api/admin/registrations/bulk-status", adminToken, gin.H{
+		"activityId": activityID, "currentStatus": models.RegistrationPending, "status": models.RegistrationApproved,
+	}, &bulk)
+	if code != http.StatusOK {
+		t.Fatalf("批量审核返回 %d", code)
+	}
+	if bulk.Updated != 1 || bulk.Failed != 4 {
+		t.Errorf("批量审核通过 %d 个、失败 %d 个, 期望 1 个、4 个", bulk.Updated, bulk.Failed)
+	}
+	for _, r := range bulk.Results {
+		if !r.Success && r.Code != "ACTIVITY_FULL" {
+			t.Errorf("报名 %d 失败原因为 %q, 期望 ACTIVITY_FULL", r.RegistrationID, r.Code)
+		}
+	}
+
+	approved, err := env.store.Registrations().CountByStatus(context.Background(), activityID,
+		[]string{models.RegistrationApproved})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if approved != 6 {
+		t.Errorf("已通过 %d 人, 期望不超过容量 6", approved)
+	}
+}
+
+// 组织者只能查看自己发布的活动的报名者，管理员不受限制
+func TestRegistrationsByActivityRequiresOwner(t *testing.T) {
+	env := newTestEnv(t)
+	env.createUser("admin", "admin123", models.RoleAdmin)
+	env.createUser("organizer1", "organizer123", models.RoleOrganizer)
+	env.createUser("organizer2", "organizer123", models.RoleOrganizer)
+	_, adminToken := env.login("admin", "admin123")
+	_, ownerToken := env.login("organizer1", "organizer123")
+	_, otherToken := env.login("organizer2", "organizer123")
+	path := "/api/activities/" + strconv.Itoa(env.createActivity(ownerToken, 10)) + "/registrations"
+
+	for _, tc := range []struct {
+		name  string
+		path  string
+		token string
+		want  int
+	}{
+		{"发布者", path, ownerToken, http.StatusOK},
+		{"管理员", path, adminToken, http.StatusOK},
+		{"其他组织者", path, otherToken, http.StatusForbidden},
+		{"不存在的活动", "/api/activities/9999/registrations", ownerToken, http.StatusNotFound},
+	} {
+		if code := env.do(http.MethodGet, tc.path, tc.token, nil, nil); code != tc.want {
+			t.Errorf("%s查看报名者返回 %d, 期望 %d", tc.name, code, tc.want)
+		}
+	}
+}
+
+// 未配置可信代理时所有请求可能共用代理的 IP，IP 策略只退避不锁定
+func TestLoginIPPolicy(t *testing.T) {
+	c := &config.Config{Login: config.LoginConfig{LockoutMinutes: 15, IPMaxFailures: 50, BaseDelaySeconds: 1, MaxDelaySeconds: 30}}
+	if p := loginIPPolicy(c); p.MaxFailures != 0 || p.FreeFailures != 50 || p.MaxDelay != 30*time.Second {
+		t.Errorf("未配置可信代理时的 IP 策略为 %+v, 期望只在 50 次后退避", p)
+	}
+
+	c.Server.TrustedProxies = []string{"10.0.0.0/8"}
+	if p := loginIPPolicy(c); p.MaxFailures != 50 || p.BaseDelay != 0 {
+		t.Errorf("配置了可信代理时的 IP 策略为 %+v, 期望 50 次后锁定", p)
+	}
+}
+
+// 导入的用户名与已有用户只有大小写不同时按重复跳过，而不是让整批插入失败
+func TestImportUsersSkipsExistingUsernameIgnoringCase(t *testing.T) {
+	env := newTestEnv(t)
+	env.createUser("admin", "admin123", models.RoleAdmin)
+	env.createUser("Alice01", "secret123", models.RoleStudent)
+	_, adminToken := env.login("admin", "admin123")
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, err := form.CreateFormFile("file", "users.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	io.WriteString(part, "username,fullName,college,password\nalice01,爱丽丝,外国语学院,secret123\nbob00001,鲍勃,外国语学院,secret123\n")
+	form.Close()
+
+	req, err := http.NewRequest(http.MethodPost, env.server.URL+"/api/admin/users/import", &body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	req.Header.Set("Authorization", "Bearer "+adminToken)
+	resp, err := env.server.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var report models.UserImportReport
+	if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("导入返回 %d", resp.StatusCode)
+	}
+	if report.Created != 1 || len(report.Rows) != 2 || report.Rows[0].Status != "skipped_duplicate" {
+		t.Errorf("导入结果为 %+v, 期望 alice01 按重复跳过、bob00001 导入成功", report)
+	}
+}
+
+// eligibleFor=me 在数据库中筛选出的活动与报名时在代码中校验资格的结果一致
+func TestEligibleForMeMatchesRegistration(t *testing.T) {
+	env := newTestEnv(t)
+	env.createUser("organizer", "organizer123", models.RoleOrganizer)
+	_, organizerToken := env.login("organizer", "organizer123")
+
+	rules := []models.EligibilityRules{
+		{},
+		{Colleges: []string{"计算机学院"}},
+		{UsernamePatterns: []string{"2023*"}},
+		{UsernamePatterns: []string{"*01"}},
+		{UsernamePatterns: []string{"CS*23*"}},
+		{UsernamePatterns: []string{"2022*", "cs*"}},
+		{Roles: []string{models.RoleOrganizer}},
+		{Colleges: []string{"外国语学院"}, UsernamePatterns: []string{"2023*"}},
+	}
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	var activityIDs []int
+	for i, r := range rules {
+		var activity models.Activity
+		code := env.do(http.MethodPost, "/api/activities", organizerToken, gin.H{
+			"title":       "资格规则活动 " + strconv.Itoa(i),
+			"organizer":   "教务处",
+			"startTime":   start,
+			"endTime":     start.Add(time.Hour),
+			"eligibility": r,
+		}, &activity)
+		if code != http.StatusCreated {
+			t.Fatalf("创建第 %d 个活动返回 %d", i, code)
+		}
+		activityIDs = append(activityIDs, activity.ID)
+	}
+
+	students := []struct{ username, college string }{
+		{"20230001", "计算机学院"},
+		{"20220001", "外国语学院"},
+		{"20230010", "外国语学院"},
+		{"cs20230101", "计算机学院"},
+		{"CS2024-23", ""},
+	}
+	for _, s := range students {
+		hash, err := bcrypt.GenerateFromPassword([]byte("secret123"), bcrypt.MinCost)
+		if err != nil {
+			t.Fatal(err)
+		}
+		user := models.User{Username: s.username, PasswordHash: string(hash), FullName: s.username, College: s.college, Role: models.RoleStudent}
+		if err := env.store.Users().Create(context.Background(), &user); err != nil {
+			t.Fatal(err)
+		}
+		_, token := env.login(s.username, "secret123")
+
+		var page models.ActivityPage
+		if code := env.do(http.MethodGet, "/api/activities?eligibleFor=me&pageSize=100", token, nil, &page); code != http.StatusOK {
+			t.Fatalf("%s 查询可报名活动返回 %d", s.username, code)
+		}
+		listed := map[int]bool{}
+		for _, a := range page.Items {
+			listed[a.ID] = true
+		}
+
+		for i, id := range activityIDs {
+			code := env.do(http.MethodPost, "/api/activities/"+strconv.Itoa(id)+"/register", token, nil, nil)
+			if accepted := code == http.StatusCreated; accepted != listed[id] {
+				t.Errorf("%s 与规则 %+v: 列表中出现 %v, 报名返回 %d", s.username, rules[i], listed[id], code)
+			}
+		}
+	}
+}
+
+// 报名状态机：非法流转返回 409，拒绝必须填写原因，每次成功的变更都在学生可见的状态历史中留下一条记录
+func TestRegistrationStatusTransitions(t *testing.T) {
+	env := newTestEnv(t)
+	env.createUser("admin", "admin123", models.RoleAdmin)
+	_, adminToken := env.login("admin", "admin123")
+	activityID := env.createActivity(adminToken, 10)
+
+	type step struct {
+		status string
+		reason string
+		want   int
+		code   string
+	}
+	tests := []struct {
+		name    string
+		steps   []step
+		history []string // 期望的状态历史（目标状态），第一条为报名时的 pending
+	}{
+		{"拒绝后不能直接通过", []step{
+			{models.RegistrationRejected, "", http.StatusBadRequest, "REASON_REQUIRED"},
+			{models.RegistrationRejected, "名额已留给本院学生", http.StatusOK, ""},
+			{models.RegistrationApproved, "", http.StatusConflict, "INVALID_STATUS_TRANSITION"},
+		}, []string{models.RegistrationPending, models.RegistrationRejected}},
+		{"签到后不能取消", []step{
+			{models.RegistrationApproved, "", http.StatusOK, ""},
+			{models.RegistrationAttended, "", http.StatusOK, ""},
+			{models.RegistrationCancelled, "", http.StatusConflict, "INVALID_STATUS_TRANSITION"},
+		}, []string{models.RegistrationPending, models.RegistrationApproved, models.RegistrationAttended}},
+		{"取消后不能签到", []step{
+			{models.RegistrationCancelled, "", http.StatusOK, ""},
+			{models.RegistrationAttended, "", http.StatusConflict, "INVALID_STATUS_TRANSITION"},
+		}, []string{models.RegistrationPending, models.RegistrationCancelled}},
+	}
+	for i, tt := range tests {
+		username := "student" + strconv.Itoa(i+1)
+		env.createUser(username, "secret123", models.RoleStudent)
+		userID, token := env.login(username, "secret123")
+		if code := env.do(http.MethodPost, "/api/activities/"+strconv.Itoa(activityID)+"/register", token, nil, nil); code != http.StatusCreated {
+			t.Fatalf("%s: 报名返回 %d", tt.name, code)
+		}
+		regID := strconv.Itoa(env.myRegistration(userID, token, activityID).RegistrationID)
+
+		for _, s := range tt.steps {
+			var resp struct {
+				Code string `json:"code"`
+			}
+			code := env.do(http.MethodPut, "/api/admin/registrations/"+regID+"/status", adminToken,
+				gin.H{"status": s.status, "reason": s.reason}, &resp)
+			if code != s.want || resp.Code != s.code {
+				t.Errorf("%s: 改为 %s 返回 %d, %q, 期望 %d, %q", tt.name, s.status, code, resp.Code, s.want, s.code)
+			}
+		}
+
+		// 学生本人可以查看状态历史，失败的变更不留下记录
+		var history []models.RegistrationStatusChange
+		if code := env.do(http.MethodGet, "/api/registrations/"+regID+"/history", token, nil, &history); code != http.StatusOK {
+			t.Fatalf("%s: 学生查询状态历史返回 %d", tt.name, code)
+		}
+		if len(history) != len(tt.history) {
+			t.Fatalf("%s: 状态历史有 %d 条, 期望 %d 条", tt.name, len(history), len(tt.history))
+		}
+		for j, change := range history {
+			if change.ToStatus != tt.history[j] || (j > 0 && change.FromStatus != tt.history[j-1]) {
+				t.Errorf("%s: 第 %d 条状态历史为 %s -> %s", tt.name, j+1, change.FromStatus, change.ToStatus)
+			}
+			if change.ToStatus == models.RegistrationRejected && change.Reason == "" {
+				t.Errorf("%s: 拒绝记录缺少原因", tt.name)
+			}
+		}
+	}
+}
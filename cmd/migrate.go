@@ -0,0 +1,128 @@
+package main
+
+import (
+	"campus-activity-api/internal/database"
+	"campus-activity-api/internal/migrate"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+)
+
+const migrateUsage = `用法: main migrate <命令> [参数]
+
+命令:
+  up               执行所有未执行的迁移
+  down [N]         回滚最近执行的 N 个迁移，默认 1 个
+  status           列出所有迁移及其执行状态
+  create NAME      在 -dir 目录下生成下一个版本号的 up/down 迁移文件
+  baseline         把基线迁移标记为已执行，用于由 campus_activity.sql 建好的旧数据库，之后执行 up 补齐后续迁移
+  seed             导入开发和演示用的示例数据，只能导入到空库中
+`
+
+// migrate 子命令，不启动 HTTP 服务
+func runMigrate(args []string) {
+	fs := flag.NewFlagSet("migrate", flag.ExitOnError)
+	dir := fs.String("dir", migrate.SourceDir, "create 命令生成迁移文件的目录")
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), migrateUsage)
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	command, rest := fs.Arg(0), fs.Args()[1:]
+
+	// create 只生成文件，不需要连接数据库
+	if command == "create" {
+		if len(rest) != 1 {
+			log.Fatal("用法: main migrate create NAME")
+		}
+		paths, err := migrate.Create(*dir, rest[0])
+		if err != nil {
+			log.Fatalf("生成迁移文件失败: %v", err)
+		}
+		for _, p := range paths {
+			fmt.Println(p)
+		}
+		return
+	}
+
+	db, err := database.InitDB()
+	if err != nil {
+		log.Fatalf("无法初始化数据库: %v", err)
+	}
+	defer db.Close()
+
+	m, err := migrate.New(db)
+	if err != nil {
+		log.Fatalf("加载迁移文件失败: %v", err)
+	}
+	ctx := context.Background()
+
+	switch command {
+	case "up":
+		done, err := m.Up(ctx)
+		for _, migration := range done {
+			fmt.Printf("已执行 %06d_%s\n", migration.Version, migration.Name)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(done) == 0 {
+			fmt.Println("没有需要执行的迁移")
+		}
+	case "down":
+		steps := 1
+		if len(rest) > 0 {
+			steps, err = strconv.Atoi(rest[0])
+			if err != nil || steps < 1 {
+				log.Fatal("回滚数量必须是正整数")
+			}
+		}
+		done, err := m.Down(ctx, steps)
+		for _, migration := range done {
+			fmt.Printf("已回滚 %06d_%s\n", migration.Version, migration.Name)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(done) == 0 {
+			fmt.Println("没有可以回滚的迁移")
+		}
+	case "status":
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, s := range statuses {
+			applied := "未执行"
+			if s.AppliedAt != nil {
+				applied = s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%06d_%-40s %s\n", s.Version, s.Name, applied)
+		}
+	case "baseline":
+		if err := m.Baseline(ctx); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println("已将基线迁移标记为已执行")
+	case "seed":
+		err := m.Seed(ctx)
+		if errors.Is(err, migrate.ErrNotEmpty) {
+			log.Fatal("数据库中已有用户数据，跳过导入示例数据")
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println("示例数据导入完成")
+	default:
+		fs.Usage()
+		os.Exit(2)
+	}
+}
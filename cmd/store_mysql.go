@@ -0,0 +1,41 @@
+//go:build !sqlite
+
+package main
+
+import (
+	"campus-activity-api/internal/database"
+	"campus-activity-api/internal/health"
+	"campus-activity-api/internal/migrate"
+	"campus-activity-api/internal/store"
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// 默认使用 MySQL，连接串为配置中的 database.dsn
+func openStore() (store.Store, *sql.DB, error) {
+	db, err := database.InitDB()
+	if err != nil {
+		return nil, nil, err
+	}
+	return store.NewMySQL(db), db, nil
+}
+
+// 就绪检查：所有打包进二进制的迁移都已执行
+// 先部署新版本、后执行 migrate up 时，新实例在迁移完成前不会接收流量
+func migrationCheck(db *sql.DB) (health.Check, error) {
+	m, err := migrate.New(db)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context) error {
+		pending, err := m.Pending(ctx)
+		if err != nil {
+			return err
+		}
+		if len(pending) > 0 {
+			return fmt.Errorf("有 %d 个迁移尚未执行，最早的是 %06d_%s", len(pending), pending[0].Version, pending[0].Name)
+		}
+		return nil
+	}, nil
+}
@@ -0,0 +1,27 @@
+//go:build sqlite
+
+package main
+
+import (
+	"campus-activity-api/internal/config"
+	"campus-activity-api/internal/health"
+	"campus-activity-api/internal/store"
+	"campus-activity-api/internal/store/sqlite"
+	"context"
+	"database/sql"
+)
+
+// 使用 go build -tags sqlite 构建时改用 SQLite，配置中的 database.dsn 为数据库文件路径，空库会自动建表
+// 用于本地开发和演示，不需要安装 MySQL；migrate 子命令仍然只支持 MySQL
+func openStore() (store.Store, *sql.DB, error) {
+	db, err := sqlite.Open(context.Background(), config.Cfg.Database.DSN)
+	if err != nil {
+		return nil, nil, err
+	}
+	return store.NewSQLite(db), db, nil
+}
+
+// SQLite 的表结构由 sqlite.Open 直接创建，不使用迁移，不需要检查
+func migrationCheck(db *sql.DB) (health.Check, error) {
+	return nil, nil
+}
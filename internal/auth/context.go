@@ -0,0 +1,21 @@
+package auth
+
+import "github.com/gin-gonic/gin"
+
+// 认证中间件把解析后的 Claims 存放在 gin 上下文中的键
+const claimsContextKey = "authClaims"
+
+// 认证通过后把 Claims 写入上下文
+func SetClaims(c *gin.Context, claims *Claims) {
+	c.Set(claimsContextKey, claims)
+}
+
+// 读取当前登录用户，未经过认证中间件或未登录时返回 false
+func CurrentUser(c *gin.Context) (*Claims, bool) {
+	value, exists := c.Get(claimsContextKey)
+	if !exists {
+		return nil, false
+	}
+	claims, ok := value.(*Claims)
+	return claims, ok && claims != nil
+}
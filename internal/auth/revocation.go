@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// 内存中的吊销列表与数据库同步的间隔，多实例部署时其他实例吊销的 token 最多延迟这么久生效
+const revocationSyncInterval = 30 * time.Second
+
+// 同步失败后第一次重试的等待时间，之后每次翻倍，不超过 revocationSyncInterval
+const revocationRetryDelay = time.Second
+
+// 已吊销 access token 的持久化来源，由数据访问层实现
+type RevocationSource interface {
+	// 所有在 now 时尚未过期的已吊销 token，jti -> 过期时间
+	RevokedAccessTokens(ctx context.Context, now time.Time) (map[string]time.Time, error)
+}
+
+// 已吊销的 access token 列表，按 jti 记录
+// 数据持久化在 RevocationSource 中，内存里保留一份未过期条目的副本，鉴权时只查内存；
+// 与数据库的同步由 Run 在后台完成，鉴权的请求路径上不访问数据库
+type RevocationList struct {
+	source RevocationSource
+
+	mu      sync.RWMutex
+	entries map[string]time.Time // jti -> token 过期时间，过期后的 token 本身就无效，无需继续记录
+}
+
+func NewRevocationList(source RevocationSource) *RevocationList {
+	return &RevocationList{source: source, entries: map[string]time.Time{}}
+}
+
+// 从数据库加载所有未过期的吊销记录，合并到内存列表中，并清理已过期的条目
+// 读取数据库期间通过 Add 加入的记录不会被覆盖
+func (l *RevocationList) Sync(ctx context.Context) error {
+	now := time.Now()
+	loaded, err := l.source.RevokedAccessTokens(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for jti, expiresAt := range loaded {
+		l.entries[jti] = expiresAt
+	}
+	for jti, expiresAt := range l.entries {
+		if expiresAt.Before(now) {
+			delete(l.entries, jti)
+		}
+	}
+	return nil
+}
+
+// 判断 jti 是否已被吊销，只查内存
+func (l *RevocationList) IsRevoked(jti string) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	_, revoked := l.entries[jti]
+	return revoked
+}
+
+// 把已持久化的吊销记录立即加入内存列表，不必等到下次同步
+// 持久化所在的事务回滚时内存中会多出一条记录，只会让该 token 提前失效
+func (l *RevocationList) Add(jti string, expiresAt time.Time) {
+	l.mu.Lock()
+	l.entries[jti] = expiresAt
+	l.mu.Unlock()
+}
+
+// 每隔 revocationSyncInterval 在后台同步一次吊销列表，直到 ctx 被取消
+// 同步失败时继续使用内存中的数据，按指数退避重试，避免存储故障期间频繁查询
+func (l *RevocationList) Run(ctx context.Context) {
+	delay := revocationSyncInterval
+	retry := revocationRetryDelay
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		if err := l.Sync(ctx); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			slog.Warn("同步 token 吊销列表失败", "error", err, "retry_in", retry.String())
+			delay = retry
+			retry = min(retry*2, revocationSyncInterval)
+		} else {
+			delay = revocationSyncInterval
+			retry = revocationRetryDelay
+		}
+		timer.Reset(delay)
+	}
+}
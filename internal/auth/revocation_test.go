@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// 返回固定结果的吊销记录来源，读取时可以模拟并发的 Add
+type fakeRevocationSource struct {
+	entries map[string]time.Time
+	during  func()
+}
+
+func (s fakeRevocationSource) RevokedAccessTokens(ctx context.Context, now time.Time) (map[string]time.Time, error) {
+	if s.during != nil {
+		s.during()
+	}
+	return s.entries, nil
+}
+
+// 同步期间加入的吊销记录不会被数据库中较旧的数据覆盖
+func TestSyncKeepsConcurrentAdd(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+	source := &fakeRevocationSource{entries: map[string]time.Time{"from-db": expiresAt}}
+	list := NewRevocationList(source)
+	source.during = func() { list.Add("logged-out", expiresAt) }
+
+	if err := list.Sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	for _, jti := range []string{"from-db", "logged-out"} {
+		if !list.IsRevoked(jti) {
+			t.Errorf("%s 应当处于吊销状态", jti)
+		}
+	}
+}
+
+// 同步时清理已过期的记录
+func TestSyncPrunesExpired(t *testing.T) {
+	list := NewRevocationList(fakeRevocationSource{})
+	list.Add("expired", time.Now().Add(-time.Minute))
+
+	if err := list.Sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if list.IsRevoked("expired") {
+		t.Error("已过期的记录应当被清理")
+	}
+}
@@ -0,0 +1,118 @@
+// 认证相关的公共实现：JWT 的签发与校验（支持按 kid 轮换密钥）、当前登录用户、access token 吊销列表
+package auth
+
+import (
+	"campus-activity-api/internal/config"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// access token 的负载
+type Claims struct {
+	UserID   int    `json:"id"`
+	Username string `json:"username"`
+	Role     string `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// 签名密钥配置无效
+var ErrNoSigningKey = errors.New("no active JWT signing key configured")
+
+// token 签发和校验器，持有所有可用的签名密钥
+type Manager struct {
+	keys        map[string][]byte
+	activeKeyID string
+	issuer      string
+	audience    string
+	ttl         time.Duration
+}
+
+// 根据配置创建 Manager，要求 activeKeyId 对应的密钥存在且不为空
+func NewManager(cfg config.JWTConfig) (*Manager, error) {
+	m := &Manager{
+		keys:        make(map[string][]byte, len(cfg.Keys)),
+		activeKeyID: cfg.ActiveKeyID,
+		issuer:      cfg.Issuer,
+		audience:    cfg.Audience,
+		ttl:         time.Duration(cfg.AccessTokenMinutes) * time.Minute,
+	}
+	for _, key := range cfg.Keys {
+		if key.ID == "" || key.Secret == "" {
+			return nil, errors.New("JWT key id and secret must not be empty")
+		}
+		m.keys[key.ID] = []byte(key.Secret)
+	}
+	if _, ok := m.keys[m.activeKeyID]; !ok {
+		return nil, ErrNoSigningKey
+	}
+	return m, nil
+}
+
+// 为用户签发 access token，返回 token 字符串和写入其中的负载
+func (m *Manager) Issue(userID int, username, role string) (string, *Claims, error) {
+	jti, err := newTokenID()
+	if err != nil {
+		return "", nil, err
+	}
+	now := time.Now()
+	claims := &Claims{
+		UserID:   userID,
+		Username: username,
+		Role:     role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        jti,
+			Subject:   strconv.Itoa(userID),
+			Issuer:    m.issuer,
+			Audience:  jwt.ClaimStrings{m.audience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(m.ttl)),
+		},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header["kid"] = m.activeKeyID
+	signed, err := token.SignedString(m.keys[m.activeKeyID])
+	if err != nil {
+		return "", nil, err
+	}
+	return signed, claims, nil
+}
+
+// 校验 token 的签名、有效期、签发方和受众，按头部的 kid 选择密钥
+// 没有 kid 或 jti 的 token 一律视为无效
+func (m *Manager) Parse(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := m.keys[kid]
+		if !ok {
+			return nil, errors.New("unknown signing key id '" + kid + "'")
+		}
+		return key, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(m.issuer),
+		jwt.WithAudience(m.audience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if claims.ID == "" || claims.UserID == 0 {
+		return nil, errors.New("token is missing jti or user id")
+	}
+	return claims, nil
+}
+
+// 生成随机的 token ID（jti）
+func newTokenID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
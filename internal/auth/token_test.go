@@ -0,0 +1,127 @@
+package auth
+
+import (
+	"campus-activity-api/internal/config"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func newTestManager(t *testing.T, activeKeyID string) *Manager {
+	t.Helper()
+	m, err := NewManager(config.JWTConfig{
+		Keys: []config.JWTKey{
+			{ID: "2025-01", Secret: "old-secret"},
+			{ID: "2025-06", Secret: "new-secret"},
+		},
+		ActiveKeyID:        activeKeyID,
+		Issuer:             "campus-activity-api",
+		Audience:           "campus-activity-web",
+		AccessTokenMinutes: 15,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+// 合法的负载，测试用例在此基础上修改个别字段
+func validClaims() *Claims {
+	now := time.Now()
+	return &Claims{
+		UserID:   1,
+		Username: "20230001",
+		Role:     "student",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        "jti-1",
+			Subject:   strconv.Itoa(1),
+			Issuer:    "campus-activity-api",
+			Audience:  jwt.ClaimStrings{"campus-activity-web"},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(15 * time.Minute)),
+		},
+	}
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, kid string, key interface{}, claims *Claims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func TestIssueAndParse(t *testing.T) {
+	m := newTestManager(t, "2025-06")
+	token, issued, err := m.Issue(1, "20230001", "student")
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims, err := m.Parse(token)
+	if err != nil {
+		t.Fatalf("校验刚签发的 token 失败: %v", err)
+	}
+	if claims.ID == "" || claims.ID != issued.ID || claims.UserID != 1 || claims.Role != "student" {
+		t.Errorf("解析出的负载为 %+v, 期望与签发时一致", claims)
+	}
+}
+
+// 轮换后不再用于签名、但仍在配置中的密钥签发的 token 继续有效
+func TestParseRetiredKey(t *testing.T) {
+	old := newTestManager(t, "2025-01")
+	token, _, err := old.Issue(1, "20230001", "student")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newTestManager(t, "2025-06").Parse(token); err != nil {
+		t.Errorf("旧密钥签发的 token 被拒绝: %v", err)
+	}
+}
+
+func TestParseRejectsInvalidTokens(t *testing.T) {
+	m := newTestManager(t, "2025-06")
+	key := []byte("new-secret")
+	if _, err := m.Parse(sign(t, jwt.SigningMethodHS256, "2025-06", key, validClaims())); err != nil {
+		t.Fatalf("合法的 token 被拒绝: %v", err)
+	}
+
+	withClaims := func(modify func(c *Claims)) *Claims {
+		c := validClaims()
+		modify(c)
+		return c
+	}
+	noneToken, err := jwt.NewWithClaims(jwt.SigningMethodNone, validClaims()).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"未知的 kid", sign(t, jwt.SigningMethodHS256, "2024-12", key, validClaims())},
+		{"没有 kid", sign(t, jwt.SigningMethodHS256, "", key, validClaims())},
+		{"kid 与密钥不符", sign(t, jwt.SigningMethodHS256, "2025-01", key, validClaims())},
+		{"签发方错误", sign(t, jwt.SigningMethodHS256, "2025-06", key, withClaims(func(c *Claims) { c.Issuer = "other-api" }))},
+		{"受众错误", sign(t, jwt.SigningMethodHS256, "2025-06", key, withClaims(func(c *Claims) { c.Audience = jwt.ClaimStrings{"other-web"} }))},
+		{"缺少 jti", sign(t, jwt.SigningMethodHS256, "2025-06", key, withClaims(func(c *Claims) { c.ID = "" }))},
+		{"缺少过期时间", sign(t, jwt.SigningMethodHS256, "2025-06", key, withClaims(func(c *Claims) { c.ExpiresAt = nil }))},
+		{"已过期", sign(t, jwt.SigningMethodHS256, "2025-06", key, withClaims(func(c *Claims) {
+			c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+		}))},
+		{"HS512 签名", sign(t, jwt.SigningMethodHS512, "2025-06", key, validClaims())},
+		{"alg 为 none", noneToken},
+	}
+	for _, tt := range tests {
+		if claims, err := m.Parse(tt.token); err == nil {
+			t.Errorf("%s: token 被接受, 负载 %+v", tt.name, claims)
+		}
+	}
+}
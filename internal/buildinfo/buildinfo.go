@@ -0,0 +1,46 @@
+// 构建信息，构建时通过 -ldflags 注入，例如：
+//
+//	go build -ldflags "-X campus-activity-api/internal/buildinfo.Commit=$(git rev-parse HEAD) \
+//	  -X campus-activity-api/internal/buildinfo.BuildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)" ./cmd
+//
+// 未注入时尝试使用 go build 自动记录的 VCS 信息
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+var (
+	Commit    string // git commit
+	BuildTime string // 构建时间，UTC，RFC 3339 格式
+)
+
+// 构建信息
+type Info struct {
+	Commit    string `json:"commit"`
+	BuildTime string `json:"buildTime"`
+	GoVersion string `json:"goVersion"`
+}
+
+func Get() Info {
+	info := Info{Commit: Commit, BuildTime: BuildTime, GoVersion: runtime.Version()}
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		for _, s := range bi.Settings {
+			switch {
+			case s.Key == "vcs.revision" && info.Commit == "":
+				info.Commit = s.Value
+			case s.Key == "vcs.time" && info.BuildTime == "":
+				// 没有构建时间时退而使用提交时间
+				info.BuildTime = s.Value
+			}
+		}
+	}
+	if info.Commit == "" {
+		info.Commit = "unknown"
+	}
+	if info.BuildTime == "" {
+		info.BuildTime = "unknown"
+	}
+	return info
+}
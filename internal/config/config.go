@@ -2,56 +2,326 @@ package config
 
 import (
 	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
 	"os"
 )
 
-// dsn 结构体
+// HTTP 服务结构体
+type ServerConfig struct {
+	// 监听地址，如 ":8080"
+	Addr string `json:"addr"`
+	// 允许跨域访问的前端地址
+	CORSOrigins []string `json:"corsOrigins"`
+	// 可信的反向代理（IP 或 CIDR），只有来自这些地址的请求才采用 X-Forwarded-For 中的客户端 IP
+	// 部署在 Azure 前端之后时填写前端的出口网段；为空时不信任任何代理，直接使用连接的对端地址
+	TrustedProxies []string `json:"trustedProxies"`
+	// 超时（秒）：读取整个请求、读取请求头、写出响应和 keep-alive 空闲连接的最长时间
+	ReadTimeoutSeconds       int `json:"readTimeoutSeconds"`
+	ReadHeaderTimeoutSeconds int `json:"readHeaderTimeoutSeconds"`
+	WriteTimeoutSeconds      int `json:"writeTimeoutSeconds"`
+	IdleTimeoutSeconds       int `json:"idleTimeoutSeconds"`
+	// 收到退出信号后等待处理中请求完成的最长时间（秒），超时后强制关闭连接
+	ShutdownTimeoutSeconds int `json:"shutdownTimeoutSeconds"`
+	// 收到退出信号后先让就绪检查失败，再等待多少秒才停止接收新请求，留给负载均衡摘除实例；默认 0 不等待
+	DrainDelaySeconds int `json:"drainDelaySeconds"`
+}
+
+// 数据库结构体
 type DatabaseConfig struct {
 	DSN string `json:"dsn"`
+	// 连接池：最大连接数、最大空闲连接数和单个连接的最长使用时间（分钟）
+	MaxOpenConns           int `json:"maxOpenConns"`
+	MaxIdleConns           int `json:"maxIdleConns"`
+	ConnMaxLifetimeMinutes int `json:"connMaxLifetimeMinutes"`
 }
 
 // JWT 结构体
 type JWTConfig struct {
+	// 单个签名密钥，未配置 keys 时作为 ID 为 "default" 的密钥使用
+	Secret string `json:"secret"`
+	// 轮换密钥：新 token 使用 activeKeyId 对应的密钥签名，其余密钥仍可用于校验旧 token
+	Keys        []JWTKey `json:"keys"`
+	ActiveKeyID string   `json:"activeKeyId"`
+	// token 的签发方和受众，校验时要求一致
+	Issuer   string `json:"issuer"`
+	Audience string `json:"audience"`
+	// access token 有效期（分钟）和 refresh token 有效期（天）
+	AccessTokenMinutes int `json:"accessTokenMinutes"`
+	RefreshTokenDays   int `json:"refreshTokenDays"`
+}
+
+// JWT 签名密钥，ID 写入 token 头部的 kid
+type JWTKey struct {
+	ID     string `json:"id"`
 	Secret string `json:"secret"`
 }
 
-// database 结构体
+// 当前用于签名的密钥
+func (j JWTConfig) ActiveSecret() string {
+	for _, key := range j.Keys {
+		if key.ID == j.ActiveKeyID {
+			return key.Secret
+		}
+	}
+	return j.Secret
+}
+
+// 现场签到结构体
+type CheckinConfig struct {
+	// 签到二维码的签名密钥，为空时使用 JWT 密钥
+	Secret string `json:"secret"`
+	// 签到窗口：活动开始前多少分钟开放签到，活动结束后多少分钟停止签到
+	OpenBeforeMinutes int `json:"openBeforeMinutes"`
+	CloseAfterMinutes int `json:"closeAfterMinutes"`
+}
+
+// 登录防暴力破解结构体
+type LoginConfig struct {
+	// 同一用户名连续失败多少次后锁定，以及锁定时长（分钟）
+	MaxFailures    int `json:"maxFailures"`
+	LockoutMinutes int `json:"lockoutMinutes"`
+	// 同一 IP 连续失败多少次后锁定，锁定时长与用户名相同；
+	// 未配置 server.trustedProxies 时取不到真实的客户端 IP，改为超过次数后开始退避、不锁定
+	IPMaxFailures int `json:"ipMaxFailures"`
+	// 失败后的退避等待：首次等待秒数，之后每次翻倍，不超过上限
+	BaseDelaySeconds int `json:"baseDelaySeconds"`
+	MaxDelaySeconds  int `json:"maxDelaySeconds"`
+}
+
+// 日志结构体
+type LogConfig struct {
+	// 日志级别：debug、info、warn、error
+	Level string `json:"level"`
+	// 输出格式：json 或 text，本地开发时 text 更易读
+	Format string `json:"format"`
+}
+
+// 全部配置
 type Config struct {
+	Server   ServerConfig   `json:"server"`
 	Database DatabaseConfig `json:"database"`
 	JWT      JWTConfig      `json:"jwt"`
+	Checkin  CheckinConfig  `json:"checkin"`
+	Login    LoginConfig    `json:"login"`
+	Log      LogConfig      `json:"log"`
 }
 
 // 全局指针 Cfg，用于存储最终加载的配置
 var Cfg *Config
 
-// 加载数据库配置
-func LoadConfig() error {
-	// 扩展性保留，实际并没有 APP_ENV 配置文件
-	env := os.Getenv("APP_ENV")
-	// 选择加载什么配置文件
-	if env == "" {
-		env = "azure"
+// 默认的配置文件路径（相对于工作目录）和环境
+const (
+	defaultConfigPath = "config/config.json"
+	defaultEnv        = "azure"
+)
+
+// 按 配置文件 → 环境变量 → 命令行参数 的顺序加载配置，后加载的覆盖先加载的，结果写入 Cfg
+// args 为命令行参数（不含程序名），返回配置参数之后剩余的参数，如 migrate 子命令
+//
+// 配置文件路径由 -config 参数或 CAMPUS_CONFIG 环境变量指定，默认为 config/config.json；
+// 文件中按环境分组，环境由 -env 参数或 APP_ENV 环境变量指定，默认为 azure。
+// 每一项配置都可以用环境变量（如 CAMPUS_DATABASE_DSN）或同名参数（如 -database.dsn）覆盖，完整列表见 settings
+func LoadConfig(args []string) ([]string, error) {
+	// 1. 解析命令行参数，只记录显式给出的项，稍后再覆盖到配置上
+	fs := flag.NewFlagSet("campus-activity-api", flag.ContinueOnError)
+	configPath := fs.String("config", "", "配置文件路径，默认 "+defaultConfigPath+"，也可以用 CAMPUS_CONFIG 指定")
+	envName := fs.String("env", "", "使用配置文件中的哪个环境，默认 "+defaultEnv+"，也可以用 APP_ENV 指定")
+	for _, s := range settings {
+		fs.String(s.key, "", s.usage+"（环境变量 "+s.envName()+"）")
 	}
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	flags := map[string]string{}
+	fs.Visit(func(f *flag.Flag) { flags[f.Name] = f.Value.String() })
+
+	// 2. 读取配置文件中对应环境的配置
+	path := firstNonEmpty(*configPath, os.Getenv("CAMPUS_CONFIG"))
+	env := firstNonEmpty(*envName, os.Getenv("APP_ENV"), defaultEnv)
+	cfg, err := loadFile(path, env)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 环境变量和命令行参数依次覆盖
+	for _, s := range settings {
+		if value, ok := os.LookupEnv(s.envName()); ok {
+			if err := s.set(&cfg, value); err != nil {
+				return nil, fmt.Errorf("环境变量 %s: %w", s.envName(), err)
+			}
+		}
+	}
+	for _, s := range settings {
+		if value, ok := flags[s.key]; ok {
+			if err := s.set(&cfg, value); err != nil {
+				return nil, fmt.Errorf("参数 -%s: %w", s.key, err)
+			}
+		}
+	}
+
+	// 4. 填充默认值，是否可以启动服务由调用方通过 Validate 校验
+	cfg.applyDefaults()
+	Cfg = &cfg
+	return fs.Args(), nil
+}
 
-	// 加载config.json文件
-	file, err := os.ReadFile("config/config.json")
+// 读取配置文件中指定环境的配置
+// 未指定路径且默认路径下没有配置文件时返回空配置，此时全部配置来自环境变量和命令行参数
+func loadFile(path, env string) (Config, error) {
+	explicit := path != ""
+	if !explicit {
+		path = defaultConfigPath
+	}
+	file, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && !explicit {
+		return Config{}, nil
+	}
 	if err != nil {
-		return err
+		return Config{}, err
 	}
 
 	// 解析 JSON 文件，映射到 allConfigs 结构体
 	var allConfigs map[string]Config
 	if err := json.Unmarshal(file, &allConfigs); err != nil {
-		return err
+		return Config{}, fmt.Errorf("解析配置文件 %s 失败: %w", path, err)
 	}
 
 	// 获取指定环境的配置
 	envConfig, ok := allConfigs[env]
 	if !ok {
-		return &ConfigError{Env: env}
+		return Config{}, &ConfigError{Env: env}
+	}
+	return envConfig, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
 	}
+	return ""
+}
 
-	Cfg = &envConfig
+// 为配置文件中未填写的可选项设置默认值
+func (c *Config) applyDefaults() {
+	if c.Server.Addr == "" {
+		c.Server.Addr = ":8080"
+	}
+	if len(c.Server.CORSOrigins) == 0 {
+		c.Server.CORSOrigins = []string{"http://localhost:5173", "https://jinjie1101.z23.web.core.windows.net"}
+	}
+	// 读取请求 15 秒、请求头 5 秒、写出响应 30 秒（导出报名表需要较长时间），空闲连接 60 秒，退出时最多等待 20 秒
+	if c.Server.ReadTimeoutSeconds <= 0 {
+		c.Server.ReadTimeoutSeconds = 15
+	}
+	if c.Server.ReadHeaderTimeoutSeconds <= 0 {
+		c.Server.ReadHeaderTimeoutSeconds = 5
+	}
+	if c.Server.WriteTimeoutSeconds <= 0 {
+		c.Server.WriteTimeoutSeconds = 30
+	}
+	if c.Server.IdleTimeoutSeconds <= 0 {
+		c.Server.IdleTimeoutSeconds = 60
+	}
+	if c.Server.ShutdownTimeoutSeconds <= 0 {
+		c.Server.ShutdownTimeoutSeconds = 20
+	}
+	// 连接池默认 10 个连接，每个连接最长使用 3 分钟
+	if c.Database.MaxOpenConns <= 0 {
+		c.Database.MaxOpenConns = 10
+	}
+	if c.Database.MaxIdleConns <= 0 {
+		c.Database.MaxIdleConns = c.Database.MaxOpenConns
+	}
+	if c.Database.ConnMaxLifetimeMinutes <= 0 {
+		c.Database.ConnMaxLifetimeMinutes = 3
+	}
+	// 未配置轮换密钥时使用单个 secret
+	if len(c.JWT.Keys) == 0 && c.JWT.Secret != "" {
+		c.JWT.Keys = []JWTKey{{ID: "default", Secret: c.JWT.Secret}}
+	}
+	if c.JWT.ActiveKeyID == "" && len(c.JWT.Keys) > 0 {
+		c.JWT.ActiveKeyID = c.JWT.Keys[0].ID
+	}
+	if c.JWT.Issuer == "" {
+		c.JWT.Issuer = "campus-activity-api"
+	}
+	if c.JWT.Audience == "" {
+		c.JWT.Audience = "campus-activity-web"
+	}
+	// access token 默认 15 分钟，refresh token 默认 14 天
+	if c.JWT.AccessTokenMinutes <= 0 {
+		c.JWT.AccessTokenMinutes = 15
+	}
+	if c.JWT.RefreshTokenDays <= 0 {
+		c.JWT.RefreshTokenDays = 14
+	}
+	// 登录限制：同一用户名失败 5 次锁定 15 分钟，同一 IP 失败 50 次锁定，退避 1 秒起、最长 30 秒
+	if c.Login.MaxFailures <= 0 {
+		c.Login.MaxFailures = 5
+	}
+	if c.Login.LockoutMinutes <= 0 {
+		c.Login.LockoutMinutes = 15
+	}
+	if c.Login.IPMaxFailures <= 0 {
+		c.Login.IPMaxFailures = 50
+	}
+	if c.Login.BaseDelaySeconds <= 0 {
+		c.Login.BaseDelaySeconds = 1
+	}
+	if c.Login.MaxDelaySeconds <= 0 {
+		c.Login.MaxDelaySeconds = 30
+	}
+	if c.Log.Level == "" {
+		c.Log.Level = "info"
+	}
+	if c.Log.Format == "" {
+		c.Log.Format = "json"
+	}
+	// 签到窗口两项都未配置时，默认活动开始前 30 分钟到结束后 30 分钟
+	if c.Checkin.OpenBeforeMinutes == 0 && c.Checkin.CloseAfterMinutes == 0 {
+		c.Checkin.OpenBeforeMinutes = 30
+		c.Checkin.CloseAfterMinutes = 30
+	}
+}
+
+// 仓库中 config.json 附带的示例密钥和常见的占位符，不能用作真正的签名密钥
+var defaultSecrets = map[string]bool{
+	"development_secret_key": true,
+	"azure_secret_key":       true,
+	"secret":                 true,
+	"changeme":               true,
+	"change-me":              true,
+}
+
+// 启动 HTTP 服务前校验配置，拒绝空的或默认的 JWT 密钥等无法安全运行的配置
+func (c *Config) Validate() error {
+	if c.Database.DSN == "" {
+		return errors.New("未配置数据库连接串 database.dsn（环境变量 CAMPUS_DATABASE_DSN）")
+	}
+	if len(c.JWT.Keys) == 0 {
+		return errors.New("未配置 JWT 密钥 jwt.secret（环境变量 CAMPUS_JWT_SECRET）")
+	}
+	for _, key := range c.JWT.Keys {
+		if key.Secret == "" {
+			return fmt.Errorf("JWT 密钥 %q 为空", key.ID)
+		}
+		if defaultSecrets[key.Secret] {
+			return fmt.Errorf("JWT 密钥 %q 使用的是默认值，请通过 CAMPUS_JWT_SECRET 或 jwt.keys 配置真正的密钥", key.ID)
+		}
+	}
+	for _, proxy := range c.Server.TrustedProxies {
+		if _, _, err := net.ParseCIDR(proxy); err != nil && net.ParseIP(proxy) == nil {
+			return fmt.Errorf("server.trustedProxies 中的 %q 不是有效的 IP 或 CIDR", proxy)
+		}
+	}
+	if c.Database.MaxIdleConns > c.Database.MaxOpenConns {
+		return errors.New("database.maxIdleConns 不能大于 database.maxOpenConns")
+	}
 	return nil
 }
 
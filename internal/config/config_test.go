@@ -0,0 +1,86 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// 写入只有 test 环境的配置文件，返回文件路径
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadConfigPrecedence(t *testing.T) {
+	path := writeConfigFile(t, `{"test": {
+		"server": {"addr": ":9000"},
+		"database": {"dsn": "file-dsn", "maxOpenConns": 20},
+		"jwt": {"secret": "file-secret"}
+	}}`)
+	t.Setenv("APP_ENV", "test")
+	t.Setenv("CAMPUS_DATABASE_DSN", "env-dsn")
+	t.Setenv("CAMPUS_SERVER_ADDR", ":9001")
+	t.Setenv("CAMPUS_SERVER_CORS_ORIGINS", "http://a.example, http://b.example")
+	t.Setenv("CAMPUS_SERVER_SHUTDOWN_TIMEOUT_SECONDS", "5")
+
+	rest, err := LoadConfig([]string{"-config", path, "-server.addr", ":9002", "migrate", "up"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Join(rest, " ") != "migrate up" {
+		t.Errorf("剩余参数为 %q, 期望 %q", rest, "migrate up")
+	}
+	// 参数覆盖环境变量，环境变量覆盖配置文件，未覆盖的项保留文件中的值
+	if Cfg.Server.Addr != ":9002" {
+		t.Errorf("server.addr = %q, 期望参数中的值", Cfg.Server.Addr)
+	}
+	if Cfg.Database.DSN != "env-dsn" {
+		t.Errorf("database.dsn = %q, 期望环境变量中的值", Cfg.Database.DSN)
+	}
+	if Cfg.Database.MaxOpenConns != 20 || Cfg.Database.MaxIdleConns != 20 {
+		t.Errorf("连接池为 %d/%d, 期望 20/20", Cfg.Database.MaxOpenConns, Cfg.Database.MaxIdleConns)
+	}
+	if got := strings.Join(Cfg.Server.CORSOrigins, ","); got != "http://a.example,http://b.example" {
+		t.Errorf("server.corsOrigins = %q", got)
+	}
+	// 未配置的超时使用默认值
+	if Cfg.Server.ShutdownTimeoutSeconds != 5 || Cfg.Server.WriteTimeoutSeconds != 30 {
+		t.Errorf("退出/写超时为 %d/%d 秒, 期望 5/30", Cfg.Server.ShutdownTimeoutSeconds, Cfg.Server.WriteTimeoutSeconds)
+	}
+	if Cfg.JWT.ActiveSecret() != "file-secret" {
+		t.Errorf("JWT 密钥为 %q, 期望配置文件中的值", Cfg.JWT.ActiveSecret())
+	}
+}
+
+func TestLoadConfigInvalidValue(t *testing.T) {
+	path := writeConfigFile(t, `{"test": {}}`)
+	t.Setenv("APP_ENV", "test")
+	t.Setenv("CAMPUS_DATABASE_MAX_OPEN_CONNS", "many")
+
+	if _, err := LoadConfig([]string{"-config", path}); err == nil || !strings.Contains(err.Error(), "CAMPUS_DATABASE_MAX_OPEN_CONNS") {
+		t.Errorf("非整数的连接数应当报错并指出环境变量, 实际为 %v", err)
+	}
+}
+
+func TestValidateRejectsDefaultSecret(t *testing.T) {
+	for _, secret := range []string{"", "development_secret_key", "azure_secret_key"} {
+		c := Config{Database: DatabaseConfig{DSN: "dsn"}, JWT: JWTConfig{Secret: secret}}
+		c.applyDefaults()
+		if err := c.Validate(); err == nil {
+			t.Errorf("JWT 密钥为 %q 时应当校验失败", secret)
+		}
+	}
+
+	c := Config{Database: DatabaseConfig{DSN: "dsn"}, JWT: JWTConfig{Secret: "a-real-secret-from-the-environment"}}
+	c.applyDefaults()
+	if err := c.Validate(); err != nil {
+		t.Errorf("有效配置校验失败: %v", err)
+	}
+}
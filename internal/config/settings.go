@@ -0,0 +1,97 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// 可以通过环境变量和命令行参数覆盖的一项配置
+// key 与配置文件中的 JSON 路径一致，同时作为命令行参数名；环境变量名由 key 转换而来，如 database.maxOpenConns -> CAMPUS_DATABASE_MAX_OPEN_CONNS
+type setting struct {
+	key   string
+	usage string
+	field func(c *Config) interface{} // 返回字段指针：*string、*int 或 *[]string
+}
+
+var settings = []setting{
+	{"server.addr", "HTTP 监听地址", func(c *Config) interface{} { return &c.Server.Addr }},
+	{"server.corsOrigins", "允许跨域访问的前端地址，多个用逗号分隔", func(c *Config) interface{} { return &c.Server.CORSOrigins }},
+	{"server.trustedProxies", "可信的反向代理 IP 或 CIDR，多个用逗号分隔，为空时不信任 X-Forwarded-For", func(c *Config) interface{} { return &c.Server.TrustedProxies }},
+	{"server.readTimeoutSeconds", "读取整个请求的超时（秒）", func(c *Config) interface{} { return &c.Server.ReadTimeoutSeconds }},
+	{"server.readHeaderTimeoutSeconds", "读取请求头的超时（秒）", func(c *Config) interface{} { return &c.Server.ReadHeaderTimeoutSeconds }},
+	{"server.writeTimeoutSeconds", "写出响应的超时（秒）", func(c *Config) interface{} { return &c.Server.WriteTimeoutSeconds }},
+	{"server.idleTimeoutSeconds", "keep-alive 空闲连接的超时（秒）", func(c *Config) interface{} { return &c.Server.IdleTimeoutSeconds }},
+	{"server.shutdownTimeoutSeconds", "退出时等待处理中请求完成的最长时间（秒）", func(c *Config) interface{} { return &c.Server.ShutdownTimeoutSeconds }},
+	{"server.drainDelaySeconds", "退出时就绪检查失败后等待多少秒再停止接收新请求", func(c *Config) interface{} { return &c.Server.DrainDelaySeconds }},
+
+	{"database.dsn", "数据库连接串", func(c *Config) interface{} { return &c.Database.DSN }},
+	{"database.maxOpenConns", "数据库最大连接数", func(c *Config) interface{} { return &c.Database.MaxOpenConns }},
+	{"database.maxIdleConns", "数据库最大空闲连接数", func(c *Config) interface{} { return &c.Database.MaxIdleConns }},
+	{"database.connMaxLifetimeMinutes", "数据库连接最长使用时间（分钟）", func(c *Config) interface{} { return &c.Database.ConnMaxLifetimeMinutes }},
+
+	{"jwt.secret", "JWT 签名密钥，配置了 jwt.keys 时不生效", func(c *Config) interface{} { return &c.JWT.Secret }},
+	{"jwt.activeKeyId", "用于签名新 token 的密钥 ID", func(c *Config) interface{} { return &c.JWT.ActiveKeyID }},
+	{"jwt.issuer", "token 签发方", func(c *Config) interface{} { return &c.JWT.Issuer }},
+	{"jwt.audience", "token 受众", func(c *Config) interface{} { return &c.JWT.Audience }},
+	{"jwt.accessTokenMinutes", "access token 有效期（分钟）", func(c *Config) interface{} { return &c.JWT.AccessTokenMinutes }},
+	{"jwt.refreshTokenDays", "refresh token 有效期（天）", func(c *Config) interface{} { return &c.JWT.RefreshTokenDays }},
+
+	{"checkin.secret", "签到二维码签名密钥，为空时使用 JWT 密钥", func(c *Config) interface{} { return &c.Checkin.Secret }},
+	{"checkin.openBeforeMinutes", "活动开始前多少分钟开放签到", func(c *Config) interface{} { return &c.Checkin.OpenBeforeMinutes }},
+	{"checkin.closeAfterMinutes", "活动结束后多少分钟停止签到", func(c *Config) interface{} { return &c.Checkin.CloseAfterMinutes }},
+
+	{"login.maxFailures", "同一用户名连续失败多少次后锁定", func(c *Config) interface{} { return &c.Login.MaxFailures }},
+	{"login.lockoutMinutes", "登录锁定时长（分钟）", func(c *Config) interface{} { return &c.Login.LockoutMinutes }},
+	{"login.ipMaxFailures", "同一 IP 连续失败多少次后锁定；未配置 server.trustedProxies 时改为开始退避、不锁定", func(c *Config) interface{} { return &c.Login.IPMaxFailures }},
+	{"login.baseDelaySeconds", "登录失败后首次等待秒数", func(c *Config) interface{} { return &c.Login.BaseDelaySeconds }},
+	{"login.maxDelaySeconds", "登录失败后等待秒数的上限", func(c *Config) interface{} { return &c.Login.MaxDelaySeconds }},
+
+	{"log.level", "日志级别：debug、info、warn、error", func(c *Config) interface{} { return &c.Log.Level }},
+	{"log.format", "日志格式：json 或 text", func(c *Config) interface{} { return &c.Log.Format }},
+}
+
+// 环境变量名：CAMPUS_ 前缀，驼峰转为下划线分隔的大写
+func (s setting) envName() string {
+	var b strings.Builder
+	b.WriteString("CAMPUS_")
+	for i, r := range s.key {
+		switch {
+		case r == '.':
+			b.WriteByte('_')
+		case unicode.IsUpper(r) && i > 0 && s.key[i-1] != '.':
+			b.WriteByte('_')
+			b.WriteRune(r)
+		default:
+			b.WriteRune(unicode.ToUpper(r))
+		}
+	}
+	return b.String()
+}
+
+// 把字符串形式的值写入配置
+func (s setting) set(c *Config, value string) error {
+	switch field := s.field(c).(type) {
+	case *string:
+		*field = value
+	case *int:
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("%q 不是整数", value)
+		}
+		*field = n
+	case *[]string:
+		list := []string{}
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+		*field = list
+	default:
+		return errors.New("不支持的配置类型")
+	}
+	return nil
+}
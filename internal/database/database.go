@@ -19,10 +19,11 @@ func InitDB() (*sql.DB, error) {
 		return nil, err
 	}
 
-	// 设置最大连接时长 3 分钟，10 个同时并发和 10 个空闲连接数
-	db.SetConnMaxLifetime(time.Minute * 3)
-	db.SetMaxOpenConns(10)
-	db.SetMaxIdleConns(10)
+	// 连接池大小和连接最长使用时间，默认值见 config.applyDefaults
+	pool := config.Cfg.Database
+	db.SetConnMaxLifetime(time.Duration(pool.ConnMaxLifetimeMinutes) * time.Minute)
+	db.SetMaxOpenConns(pool.MaxOpenConns)
+	db.SetMaxIdleConns(pool.MaxIdleConns)
 
 	// 测试连接
 	if err = db.Ping(); err != nil {
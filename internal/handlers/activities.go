@@ -2,71 +2,168 @@
 package handlers
 
 import (
+	"campus-activity-api/internal/logging"
 	"campus-activity-api/internal/models"
-	"database/sql"
-	"log"
+	"campus-activity-api/internal/store"
+	"encoding/json"
+	"errors"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
-// 获取活动列表，支持条件筛选
-func GetActivities(c *gin.Context) {
-	// 从查询参数获取筛选条件
-	category := c.Query("category")
-	// 从查询参数获取搜索关键词
-	search := c.Query("search")
-	// 构建基础查询语句
-	query := "SELECT id, title, description, category, organizer, location, start_time, end_time, capacity, created_by_id FROM activities"
-	// 动态添加筛选条件
-	conditions := []string{}
-	// 动态添加查询参数
-	args := []interface{}{}
-	// 根据是否提供筛选条件，构建 WHERE 子句
-	if category != "" {
-		conditions = append(conditions, "category = ?")
-		args = append(args, category)
-	}
-	// 根据搜索关键词模糊匹配标题
-	if search != "" {
-		conditions = append(conditions, "title LIKE ?")
-		args = append(args, "%"+search+"%")
-	}
-	// 拼接最终查询语句
-	if len(conditions) > 0 {
-		query += " WHERE " + strings.Join(conditions, " AND ")
-	}
-	// 默认按开始时间降序排列
-	query += " ORDER BY start_time DESC"
-	// 执行查询
-	rows, err := DB.Query(query, args...)
+// 修改活动时在事务中发现的业务错误，事务回滚后再转换为响应
+var (
+	errInvalidActivity       = errors.New("invalid activity")
+	errCapacityBelowApproved = errors.New("capacity below approved registrations")
+)
+
+// 活动列表分页参数的默认值和上限
+const (
+	defaultActivityPageSize = 20
+	maxActivityPageSize     = 100
+)
+
+// 解析日期查询参数，支持 "2006-01-02" 和 RFC3339 两种格式
+// 只给出日期时，endOfDay 为 true 则取次日零点，用于“截止到某天（含）”的半开区间
+func parseDateParam(value string, endOfDay bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation("2006-01-02", value, time.Local)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "查询活动失败: " + err.Error()})
-		return
+		return time.Time{}, err
+	}
+	if endOfDay {
+		t = t.AddDate(0, 0, 1)
 	}
-	defer rows.Close()
+	return t, nil
+}
 
-	// 遍历结果集，构建活动切片，返回给前端
-	activities := []models.Activity{}
-	for rows.Next() {
-		var a models.Activity
-		if err := rows.Scan(&a.ID, &a.Title, &a.Description, &a.Category, &a.Organizer, &a.Location, &a.StartTime, &a.EndTime, &a.Capacity, &a.CreatedByID); err != nil {
-			log.Println("扫描活动数据失败:", err)
+// 获取活动列表，支持条件筛选、排序和分页
+func (h *Handler) GetActivities(c *gin.Context) {
+	// 1. 精确匹配分类和举办方，模糊匹配标题和地点
+	filter := store.ActivityFilter{
+		Category:  c.Query("category"),
+		Organizer: c.Query("organizer"),
+		Search:    c.Query("search"),
+		Location:  c.Query("location"),
+	}
+
+	// 2. 开始时间 / 结束时间的日期范围，均为左闭右开区间
+	dateFilters := []struct {
+		param    string
+		target   **time.Time
+		endOfDay bool
+	}{
+		{"startFrom", &filter.StartFrom, false},
+		{"startTo", &filter.StartTo, true},
+		{"endFrom", &filter.EndFrom, false},
+		{"endTo", &filter.EndTo, true},
+	}
+	for _, f := range dateFilters {
+		value := c.Query(f.param)
+		if value == "" {
 			continue
 		}
-		activities = append(activities, a)
+		t, err := parseDateParam(value, f.endOfDay)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "日期参数 " + f.param + " 格式无效", "code": "INVALID_QUERY"})
+			return
+		}
+		*f.target = &t
+	}
+
+	// 3. 按活动进行状态筛选：未开始 / 进行中 / 已结束
+	switch status := c.Query("status"); status {
+	case "":
+	case store.ActivityUpcoming, store.ActivityOngoing, store.ActivityPast:
+		filter.Status = status
+		filter.Now = time.Now()
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "status 必须是 upcoming、ongoing 或 past", "code": "INVALID_QUERY"})
+		return
+	}
+
+	// 4. 按报名资格筛选：eligibleFor=me 只返回当前登录用户有资格报名的活动
+	switch c.Query("eligibleFor") {
+	case "":
+	case "me":
+		uid, _, ok := currentUser(c)
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "eligibleFor=me 需要登录", "code": "UNAUTHORIZED"})
+			return
+		}
+		user, err := h.Store.Users().Get(c.Request.Context(), uid)
+		if err != nil {
+			logging.FromContext(c.Request.Context()).Error("查询用户信息失败", "error", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "查询活动失败"})
+			return
+		}
+		filter.EligibleFor = &user
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "eligibleFor 只支持 me", "code": "INVALID_QUERY"})
+		return
+	}
+
+	// 5. 排序字段和方向走白名单，默认按开始时间降序
+	filter.Sort = c.DefaultQuery("sort", "startTime")
+	if !store.ValidActivitySort(filter.Sort) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "不支持的排序字段: " + filter.Sort, "code": "INVALID_QUERY"})
+		return
+	}
+	switch strings.ToUpper(c.DefaultQuery("order", "desc")) {
+	case "ASC":
+	case "DESC":
+		filter.Desc = true
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "order 必须是 asc 或 desc", "code": "INVALID_QUERY"})
+		return
+	}
+
+	// 6. 分页参数
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "page 必须是正整数", "code": "INVALID_QUERY"})
+		return
+	}
+	pageSize, err := strconv.Atoi(c.DefaultQuery("pageSize", strconv.Itoa(defaultActivityPageSize)))
+	if err != nil || pageSize < 1 || pageSize > maxActivityPageSize {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "pageSize 必须在 1 到 " + strconv.Itoa(maxActivityPageSize) + " 之间", "code": "INVALID_QUERY"})
+		return
 	}
-	c.JSON(http.StatusOK, activities)
+	filter.Limit = pageSize
+	filter.Offset = (page - 1) * pageSize
+
+	// 7. 查询当前页数据和总数
+	items, total, err := h.Store.Activities().List(c.Request.Context(), filter)
+	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("查询活动失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "查询活动失败"})
+		return
+	}
+	c.JSON(http.StatusOK, models.ActivityPage{
+		Items:      items,
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: (total + pageSize - 1) / pageSize,
+	})
 }
 
 // 根据活动 ID 获取单个活动的详细信息
-func GetActivityByID(c *gin.Context) {
-	id := c.Param("id")
-	var a models.Activity
-	err := DB.QueryRow("SELECT id, title, description, category, organizer, location, start_time, end_time, capacity, created_by_id FROM activities WHERE id = ?", id).Scan(&a.ID, &a.Title, &a.Description, &a.Category, &a.Organizer, &a.Location, &a.StartTime, &a.EndTime, &a.Capacity, &a.CreatedByID)
+func (h *Handler) GetActivityByID(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "活动未找到"})
+		return
+	}
+	a, err := h.Store.Activities().Get(c.Request.Context(), id)
 	if err != nil {
-		if err == sql.ErrNoRows {
+		if errors.Is(err, store.ErrNotFound) {
 			c.JSON(http.StatusNotFound, gin.H{"error": "活动未找到"})
 			return
 		}
@@ -77,7 +174,7 @@ func GetActivityByID(c *gin.Context) {
 }
 
 // 创建一个新活动
-func CreateActivity(c *gin.Context) {
+func (h *Handler) CreateActivity(c *gin.Context) {
 	// 定义一个 Activity 变量来接收前端传来的数据，使用 models 包中的 Activity 结构体
 	var activity models.Activity
 
@@ -89,77 +186,303 @@ func CreateActivity(c *gin.Context) {
 	}
 
 	// 2. 后端数据验证
-	if activity.Title == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "活动标题不能为空"})
-		return
-	}
-	if activity.EndTime.Before(activity.StartTime) {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "结束时间不能早于开始时间"})
+	if msg := validateActivity(&activity); msg != "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": msg})
 		return
 	}
-	// 可以添加更多验证...
 
 	// 从auth中间件获取用户ID，作为活动的创建者
-	userID, exists := c.Get("userID")
-	if !exists {
-		// 如果中间件没有设置 userID，说明用户未认证
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "用户未认证，无法发布活动"})
+	uid, _, ok := currentUser(c)
+	if !ok {
+		// 如果中间件没有设置用户信息，说明用户未认证
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "用户未认证，无法发布活动", "code": "UNAUTHORIZED"})
 		return
 	}
+	activity.CreatedByID = uid
 
-	// 类型断言，将 any/interface{} 转换为 int
-	if uid, ok := userID.(float64); ok {
-		activity.CreatedByID = int(uid)
-	} else {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "无法解析用户ID"})
+	// 4. 活动和报名资格规则在同一事务中写入
+	ctx := c.Request.Context()
+	err := h.Store.WithTx(ctx, func(tx store.Store) error {
+		return tx.Activities().Create(ctx, &activity)
+	})
+	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("创建活动失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "服务器内部错误，创建活动失败"})
 		return
 	}
 
-	// 4. 执行数据库插入操作
-	query := `
-		INSERT INTO activities(title, description, category, organizer, location, start_time, end_time, capacity, created_by_id) 
-		VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	// 返回包含新活动的详细信息，减小开销
+	c.JSON(http.StatusCreated, activity)
+}
+
+// 活动数据校验，创建和修改活动共用，返回空字符串表示校验通过
+func validateActivity(activity *models.Activity) string {
+	if activity.Title == "" {
+		return "活动标题不能为空"
+	}
+	if activity.EndTime.Before(activity.StartTime) {
+		return "结束时间不能早于开始时间"
+	}
+	if activity.Capacity < 0 {
+		return "活动容量不能为负数"
+	}
+	// 报名窗口必须落在活动结束之前，取消截止时间不能晚于活动开始
+	if activity.RegistrationOpensAt != nil && !activity.RegistrationOpensAt.Before(activity.EndTime) {
+		return "报名开始时间必须早于活动结束时间"
+	}
+	if activity.RegistrationClosesAt != nil {
+		if activity.RegistrationClosesAt.After(activity.EndTime) {
+			return "报名截止时间不能晚于活动结束时间"
+		}
+		if activity.RegistrationOpensAt != nil && !activity.RegistrationOpensAt.Before(*activity.RegistrationClosesAt) {
+			return "报名开始时间必须早于报名截止时间"
+		}
+	}
+	if activity.CancellationDeadline != nil && activity.CancellationDeadline.After(activity.StartTime) {
+		return "取消报名截止时间不能晚于活动开始时间"
+	}
+	// 未指定审核策略时默认人工审核
+	if activity.ApprovalPolicy == "" {
+		activity.ApprovalPolicy = models.ApprovalManual
+	}
+	switch activity.ApprovalPolicy {
+	case models.ApprovalManual, models.ApprovalAuto:
+	case models.ApprovalAutoColleges:
+		if len(activity.AutoApproveColleges) == 0 {
+			return "按学院自动审核时必须指定学院列表"
+		}
+	default:
+		return "无效的审核策略: " + activity.ApprovalPolicy
+	}
+	if activity.AutoApproveColleges == nil {
+		activity.AutoApproveColleges = []string{}
+	}
+	return normalizeEligibility(&activity.Eligibility)
+}
+
+// 可以清空的时间字段：未传时 Set 为 false；传 null 时 Set 为 true、Time 为 nil，表示清空
+// 普通指针字段无法区分未传和 null
+type nullableTime struct {
+	Set  bool
+	Time *time.Time
+}
+
+// encoding/json 遇到 null 时也会调用 UnmarshalJSON
+func (n *nullableTime) UnmarshalJSON(data []byte) error {
+	n.Set = true
+	if string(data) == "null" {
+		n.Time = nil
+		return nil
+	}
+	var t time.Time
+	if err := json.Unmarshal(data, &t); err != nil {
+		return err
+	}
+	n.Time = &t
+	return nil
+}
+
+// 修改活动的请求体，字段为 nil 表示不修改
+// 报名开放、截止时间和取消截止时间传 null 表示清空
+type activityUpdateRequest struct {
+	Title       *string    `json:"title"`
+	Description *string    `json:"description"`
+	Category    *string    `json:"category"`
+	Organizer   *string    `json:"organizer"`
+	Location    *string    `json:"location"`
+	StartTime   *time.Time `json:"startTime"`
+	EndTime     *time.Time `json:"endTime"`
+	Capacity    *int       `json:"capacity"`
+
+	ApprovalPolicy      *string   `json:"approvalPolicy"`
+	AutoApproveColleges *[]string `json:"autoApproveColleges"`
 
-	// 5. 使用 ExecContext 执行插入，并获取结果
-	result, err := DB.ExecContext(c.Request.Context(), query,
-		activity.Title, activity.Description, activity.Category, activity.Organizer,
-		activity.Location, activity.StartTime, activity.EndTime, activity.Capacity, activity.CreatedByID,
-	)
+	RegistrationOpensAt  nullableTime `json:"registrationOpensAt"`
+	RegistrationClosesAt nullableTime `json:"registrationClosesAt"`
+	CancellationDeadline nullableTime `json:"cancellationDeadline"`
 
+	// 报名资格规则整体替换，不传表示不修改
+	Eligibility *models.EligibilityRules `json:"eligibility"`
+}
+
+// 部分更新一个活动，只有活动发布者或管理员可以修改
+func (h *Handler) UpdateActivity(c *gin.Context) {
+	// 从URL参数中获取活动ID
+	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "服务器内部错误，创建活动失败"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的活动ID"})
 		return
 	}
 
-	// 5. 获取新插入行的ID，并返回完整的活动对象
-	id, err := result.LastInsertId()
-	if err != nil {
-		c.JSON(http.StatusCreated, gin.H{"message": "活动创建成功"})
+	uid, role, ok := currentUser(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "用户未登录", "code": "UNAUTHORIZED"})
 		return
 	}
 
-	activity.ID = int(id) // 将新ID赋值给对象
+	// 1. 绑定 JSON 数据
+	var req activityUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "请求的数据格式无效"})
+		return
+	}
 
-	// 返回包含新活动的详细信息，减小开销
-	c.JSON(http.StatusCreated, activity)
+	// 2. 校验活动归属
+	ctx := c.Request.Context()
+	err = authorizeActivityOwner(ctx, h.Store, id, uid, role)
+	switch {
+	case err == nil:
+	case errors.Is(err, ErrActivityNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "活动未找到", "code": "NOT_FOUND"})
+		return
+	case errors.Is(err, ErrNotActivityOwner):
+		c.JSON(http.StatusForbidden, gin.H{"error": "只有活动发布者或管理员可以修改该活动", "code": "FORBIDDEN"})
+		return
+	default:
+		logging.FromContext(c.Request.Context()).Error("查询活动归属失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "修改活动失败"})
+		return
+	}
+
+	// 3. 在事务中锁定活动行，避免与并发报名交错导致容量校验失效
+	var a models.Activity
+	var invalidMsg string
+	var approved int
+	err = h.Store.WithTx(ctx, func(tx store.Store) error {
+		var err error
+		a, err = tx.Activities().GetForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		// 4. 合并需要修改的字段，并使用与创建活动相同的校验规则
+		if req.Title != nil {
+			a.Title = *req.Title
+		}
+		if req.Description != nil {
+			a.Description = *req.Description
+		}
+		if req.Category != nil {
+			a.Category = *req.Category
+		}
+		if req.Organizer != nil {
+			a.Organizer = *req.Organizer
+		}
+		if req.Location != nil {
+			a.Location = *req.Location
+		}
+		if req.StartTime != nil {
+			a.StartTime = *req.StartTime
+		}
+		if req.EndTime != nil {
+			a.EndTime = *req.EndTime
+		}
+		if req.Capacity != nil {
+			a.Capacity = *req.Capacity
+		}
+		if req.ApprovalPolicy != nil {
+			a.ApprovalPolicy = *req.ApprovalPolicy
+		}
+		if req.AutoApproveColleges != nil {
+			a.AutoApproveColleges = *req.AutoApproveColleges
+		}
+		if req.RegistrationOpensAt.Set {
+			a.RegistrationOpensAt = req.RegistrationOpensAt.Time
+		}
+		if req.RegistrationClosesAt.Set {
+			a.RegistrationClosesAt = req.RegistrationClosesAt.Time
+		}
+		if req.CancellationDeadline.Set {
+			a.CancellationDeadline = req.CancellationDeadline.Time
+		}
+		if req.Eligibility != nil {
+			a.Eligibility = *req.Eligibility
+		}
+		if invalidMsg = validateActivity(&a); invalidMsg != "" {
+			return errInvalidActivity
+		}
+
+		// 5. 容量不能低于已审核通过的人数（0 为不限）
+		if req.Capacity != nil && a.Capacity > 0 {
+			approved, err = tx.Registrations().CountByStatus(ctx, id, approvedStatusList)
+			if err != nil {
+				return err
+			}
+			if a.Capacity < approved {
+				return errCapacityBelowApproved
+			}
+		}
+
+		// 6. 执行更新
+		if err := tx.Activities().Update(ctx, &a); err != nil {
+			return err
+		}
+
+		// 7. 容量扩大后可能空出名额，递补候补名单
+		if req.Capacity != nil {
+			if _, err := promoteFromWaitlist(ctx, tx, a, 0); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	switch {
+	case err == nil:
+	case errors.Is(err, store.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "活动未找到", "code": "NOT_FOUND"})
+		return
+	case errors.Is(err, errInvalidActivity):
+		c.JSON(http.StatusBadRequest, gin.H{"error": invalidMsg})
+		return
+	case errors.Is(err, errCapacityBelowApproved):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "活动容量不能低于已审核通过的人数 (" + strconv.Itoa(approved) + ")",
+			"code":  "CAPACITY_BELOW_APPROVED",
+		})
+		return
+	default:
+		logging.FromContext(c.Request.Context()).Error("修改活动失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "服务器内部错误，修改活动失败"})
+		return
+	}
+
+	c.JSON(http.StatusOK, a)
 }
 
-// 删除一个活动
-func DeleteActivity(c *gin.Context) {
+// 删除一个活动，只有活动发布者或管理员可以删除
+func (h *Handler) DeleteActivity(c *gin.Context) {
 	// 从URL参数中获取活动ID
-	id := c.Param("id")
-
-	// 准备删除语句
-	stmt, err := DB.Prepare("DELETE FROM activities WHERE id = ?")
+	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的活动ID"})
+		return
+	}
+
+	uid, role, ok := currentUser(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "用户未登录", "code": "UNAUTHORIZED"})
+		return
+	}
+
+	// 校验活动归属
+	err = authorizeActivityOwner(c.Request.Context(), h.Store, id, uid, role)
+	switch {
+	case err == nil:
+	case errors.Is(err, ErrActivityNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "活动未找到", "code": "NOT_FOUND"})
+		return
+	case errors.Is(err, ErrNotActivityOwner):
+		c.JSON(http.StatusForbidden, gin.H{"error": "只有活动发布者或管理员可以删除该活动", "code": "FORBIDDEN"})
+		return
+	default:
+		logging.FromContext(c.Request.Context()).Error("查询活动归属失败", "error", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "删除活动失败"})
 		return
 	}
-	defer stmt.Close()
 
 	// 执行删除
-	_, err = stmt.Exec(id)
-	if err != nil {
+	if err := h.Store.Activities().Delete(c.Request.Context(), id); err != nil {
+		logging.FromContext(c.Request.Context()).Error("删除活动失败", "error", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "执行删除活动失败"})
 		return
 	}
@@ -2,212 +2,231 @@
 package handlers
 
 import (
+	"campus-activity-api/internal/logging"
 	"campus-activity-api/internal/models"
-	"database/sql"
-	"log"
+	"campus-activity-api/internal/store"
+	"errors"
 	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
 )
 
-// 从数据库中获取所有用户报名信息
-func GetAllRegistrations(db *sql.DB) ([]models.RegistrationDetails, error) {
-	query := `
-        SELECT
-            r.id,
-            r.activity_id,
-            a.title,
-            r.user_id,
-            u.full_name,
-            u.college,
-            r.registration_time,
-            r.status
-        FROM registrations r
-        JOIN users u ON r.user_id = u.id
-        JOIN activities a ON r.activity_id = a.id
-        ORDER BY r.registration_time DESC
-    `
-	rows, err := db.Query(query)
+// 获取系统中所有用户的报名信息
+func (h *Handler) GetRegistrations(c *gin.Context) {
+	registrations, err := h.Store.Registrations().ListAll(c.Request.Context())
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	// 创建空切片存放结果，遍历查询结果，放入切片
-	var registrations []models.RegistrationDetails
-	for rows.Next() {
-		var reg models.RegistrationDetails
-		if err := rows.Scan(
-			&reg.RegistrationID,
-			&reg.ActivityID,
-			&reg.ActivityTitle,
-			&reg.UserID,
-			&reg.UserFullName,
-			&reg.UserCollege,
-			&reg.RegistrationTime,
-			&reg.Status,
-		); err != nil {
-			return nil, err
-		}
-		registrations = append(registrations, reg)
+		logging.FromContext(c.Request.Context()).Error("查询报名信息失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve registrations"})
+		return
 	}
-	return registrations, nil
+	c.JSON(http.StatusOK, registrations)
 }
 
-// 获取系统中所有用户的报名信息，调用 GetAllRegistrations 函数
-func GetRegistrationsHandler(db *sql.DB) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		registrations, err := GetAllRegistrations(db)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve registrations"})
-			return
-		}
-		c.JSON(http.StatusOK, registrations)
+// 管理员修改某个报名的状态，调用 UpdateRegistrationStatus 函数，状态流转受状态机约束
+func (h *Handler) AdminUpdateRegistrationStatus(c *gin.Context) {
+	// 从 URL 获取报名ID
+	registrationID, err := strconv.Atoi(c.Param("registrationId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的报名ID"})
+		return
 	}
+	// 从请求体获取新的状态，拒绝时需要填写原因
+	var req struct {
+		Status string `json:"status" binding:"required"`
+		Reason string `json:"reason"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "请求体无效, 需要 'status' 字段"})
+		return
+	}
+	// 验证 status 值是否合法
+	if !isRegistrationStatus(req.Status) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的报名状态: " + req.Status, "code": "INVALID_STATUS"})
+		return
+	}
+	uid, _, _ := currentUser(c)
+
+	// 在事务中按状态机规则更新状态
+	ctx := c.Request.Context()
+	err = h.Store.WithTx(ctx, func(tx store.Store) error {
+		return UpdateRegistrationStatus(ctx, tx, registrationID, req.Status, req.Reason, uid)
+	})
+	if status, body, ok := statusChangeErrorResponse(err); ok {
+		c.JSON(status, body)
+		return
+	}
+	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("更新报名状态失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "更新报名状态失败"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "报名状态更新成功", "status": req.Status})
 }
 
-// 更新某个报名记录的 status 字段，设置报名审核通过或待审核状态
-func UpdateRegistrationStatus(db *sql.DB, registrationID int, status string) error {
-	query := "UPDATE registrations SET status = ? WHERE id = ?"
-	_, err := db.Exec(query, status, registrationID)
-	return err
-}
-
-// 管理员修改某个报名的状态，调用 UpdateRegistrationStatus 函数
-func AdminUpdateRegistrationStatusHandler(db *sql.DB) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		// 从 URL 获取报名ID
-		registrationID, err := strconv.Atoi(c.Param("registrationId"))
-		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "无效的报名ID"})
-			return
-		}
-		// 从请求体获取新的状态
-		var req struct {
-			Status string `json:"status" binding:"required"`
-		}
-		if err := c.ShouldBindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "请求体无效, 需要 'status' 字段"})
-			return
-		}
-		// 验证 status 值是否合法
-		if req.Status != "approved" && req.Status != "pending" {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "状态值必须是 'approved' 或 'pending'"})
-			return
+// 批量修改报名状态单次最多处理的记录数
+const maxBulkStatusItems = 1000
+
+// 按筛选条件匹配到的报名记录超过单次上限
+var errTooManyBulkItems = errors.New("too many registrations matched")
+
+// 管理员批量修改报名状态，可以直接给出报名ID列表，也可以按“活动 + 当前状态”筛选
+// 所有变更在同一个事务中完成，逐条走 UpdateRegistrationStatus 的状态机和容量校验，返回每条记录的处理结果
+func (h *Handler) AdminBulkUpdateRegistrationStatus(c *gin.Context) {
+	var req struct {
+		IDs           []int  `json:"ids"`
+		ActivityID    int    `json:"activityId"`
+		CurrentStatus string `json:"currentStatus"`
+		Status        string `json:"status" binding:"required"`
+		Reason        string `json:"reason"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "请求体无效, 需要 'status' 字段"})
+		return
+	}
+	if !isRegistrationStatus(req.Status) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的报名状态: " + req.Status, "code": "INVALID_STATUS"})
+		return
+	}
+	// ids 和筛选条件二选一
+	useFilter := len(req.IDs) == 0
+	if useFilter && (req.ActivityID == 0 || !isRegistrationStatus(req.CurrentStatus)) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "需要提供 'ids'，或同时提供 'activityId' 和 'currentStatus'"})
+		return
+	}
+	if len(req.IDs) > maxBulkStatusItems {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "单次最多处理 " + strconv.Itoa(maxBulkStatusItems) + " 条报名记录"})
+		return
+	}
+	uid, _, _ := currentUser(c)
+
+	ctx := c.Request.Context()
+	var results []models.BulkStatusResult
+	updated := 0
+	err := h.Store.WithTx(ctx, func(tx store.Store) error {
+		// 1. 按筛选条件取出报名ID，按报名时间排序，名额不足时先报名的优先
+		ids := req.IDs
+		if useFilter {
+			var err error
+			ids, err = tx.Registrations().IDsByStatus(ctx, req.ActivityID, req.CurrentStatus)
+			if err != nil {
+				return err
+			}
+			if len(ids) > maxBulkStatusItems {
+				return errTooManyBulkItems
+			}
 		}
-		// 调用数据库函数更新状态
-		if err := UpdateRegistrationStatus(db, registrationID, req.Status); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "更新报名状态失败"})
-			return
+
+		// 2. 逐条变更状态
+		// UpdateRegistrationStatus 在写入之前完成全部业务校验，单条失败不会留下部分写入，可以继续处理后续记录；
+		// 数据库错误则回滚整个批次
+		results = make([]models.BulkStatusResult, 0, len(ids))
+		seen := make(map[int]bool, len(ids))
+		for _, id := range ids {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+
+			result := models.BulkStatusResult{RegistrationID: id}
+			err := UpdateRegistrationStatus(ctx, tx, id, req.Status, req.Reason, uid)
+			if _, body, ok := statusChangeErrorResponse(err); ok {
+				result.Code, _ = body["code"].(string)
+				result.Error, _ = body["error"].(string)
+			} else if err != nil {
+				return err
+			} else {
+				result.Success = true
+				updated++
+			}
+			results = append(results, result)
 		}
-		c.JSON(http.StatusOK, gin.H{"message": "报名状态更新成功"})
+		return nil
+	})
+	if errors.Is(err, errTooManyBulkItems) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "匹配的报名记录超过 " + strconv.Itoa(maxBulkStatusItems) + " 条，请缩小范围"})
+		return
 	}
-}
-
-// 根据 activityID 查询该活动下的所有报名用户信息
-func GetRegistrationsByActivityID(db *sql.DB, activityID int) ([]models.RegistrationDetailsForActivity, error) {
-	query := `
-        SELECT
-            r.id, 
-            u.id,
-            u.username,
-            u.full_name,
-            u.college,
-            r.registration_time,
-            r.status 
-        FROM registrations r
-        JOIN users u ON r.user_id = u.id
-        WHERE r.activity_id = ?
-        ORDER BY r.registration_time ASC
-    `
-	rows, err := db.Query(query, activityID)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	// 创建空切片存放结果，遍历查询结果，放入切片
-	var registrants []models.RegistrationDetailsForActivity
-	for rows.Next() {
-		var reg models.RegistrationDetailsForActivity
-		if err := rows.Scan(
-			&reg.RegistrationID,
-			&reg.UserID,
-			&reg.Username,
-			&reg.UserFullName,
-			&reg.UserCollege,
-			&reg.RegistrationTime,
-			&reg.Status,
-		); err != nil {
-			return nil, err
-		}
-		registrants = append(registrants, reg)
+		logging.FromContext(c.Request.Context()).Error("批量更新报名状态失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "批量更新报名状态失败，所有变更已回滚"})
+		return
 	}
-	return registrants, nil
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  req.Status,
+		"updated": updated,
+		"failed":  len(results) - updated,
+		"results": results,
+	})
 }
 
-// 根据活动 ID 获取该活动的所有报名者信息，并返回给前端
-func GetRegistrationsByActivityIDHandler(db *sql.DB) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		// 从 URL 中获取活动 ID
-		activityID, err := strconv.Atoi(c.Param("id"))
-		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "活动ID无效"})
-			return
-		}
+// 根据活动 ID 获取该活动的所有报名者信息，并返回给前端；组织者只能查看自己发布的活动
+func (h *Handler) GetRegistrationsByActivityID(c *gin.Context) {
+	// 从 URL 中获取活动 ID
+	activityID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "活动ID无效"})
+		return
+	}
 
-		// 调用新的数据库函数
-		registrants, err := GetRegistrationsByActivityID(db, activityID)
-		if err != nil {
-			// 可以在这里增加日志记录 err 的具体信息
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "获取报名者信息失败: " + err.Error()})
-			return
-		}
+	uid, role, ok := currentUser(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "用户未登录", "code": "UNAUTHORIZED"})
+		return
+	}
 
-		// 如果没有报名者，返回一个空数组而不是错误
-		if registrants == nil {
-			c.JSON(http.StatusOK, []models.RegistrationDetailsForActivity{})
-			return
-		}
+	// 组织者只能查看自己发布的活动，管理员不受限制
+	err = authorizeActivityOwner(c.Request.Context(), h.Store, activityID, uid, role)
+	switch {
+	case err == nil:
+	case errors.Is(err, ErrActivityNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "活动未找到", "code": "NOT_FOUND"})
+		return
+	case errors.Is(err, ErrNotActivityOwner):
+		c.JSON(http.StatusForbidden, gin.H{"error": "只有活动发布者或管理员可以查看报名者信息", "code": "FORBIDDEN"})
+		return
+	default:
+		logging.FromContext(c.Request.Context()).Error("查询活动归属失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "获取报名者信息失败"})
+		return
+	}
 
-		c.JSON(http.StatusOK, registrants)
+	// 没有报名者时返回空数组
+	registrants, err := h.Store.Registrations().ListByActivity(c.Request.Context(), activityID)
+	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("查询报名者信息失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "获取报名者信息失败"})
+		return
 	}
+
+	c.JSON(http.StatusOK, registrants)
 }
 
 // 管理员删除某个用户的报名记录
-func AdminDeleteRegistrationHandler(db *sql.DB) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		// 1. 从 URL 获取要删除的报名记录 ID
-		registrationID, err := strconv.Atoi(c.Param("id"))
-		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "无效的报名ID"})
-			return
-		}
-
-		// 2. 直接根据 registrationID 执行删除操作
-		query := "DELETE FROM registrations WHERE id = ?"
-		result, err := db.Exec(query, registrationID)
-		if err != nil {
-			log.Printf("删除报名记录失败: %v", err)
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "删除报名记录失败"})
-			return
-		}
+func (h *Handler) AdminDeleteRegistration(c *gin.Context) {
+	// 1. 从 URL 获取要删除的报名记录 ID
+	registrationID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的报名ID"})
+		return
+	}
 
-		// 3. 检查是否真的删除了记录
-		rowsAffected, err := result.RowsAffected()
-		if err != nil {
-			log.Printf("获取影响行数失败: %v", err)
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "操作失败，无法确认结果"})
-			return
-		}
-		if rowsAffected == 0 {
-			// 如果没有行被影响，说明这个ID可能一开始就不存在
-			c.JSON(http.StatusNotFound, gin.H{"error": "该报名记录不存在"})
-			return
-		}
+	// 2. 删除报名记录，若释放了名额则自动递补候补名单
+	deleted, err := DeleteRegistrationAndPromote(c.Request.Context(), h.Store, registrationID)
+	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("删除报名记录失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "删除报名记录失败"})
+		return
+	}
 
-		// 4. 返回成功响应
-		c.JSON(http.StatusOK, gin.H{"message": "删除报名成功"})
+	// 3. 检查是否真的删除了记录
+	if !deleted {
+		// 如果没有记录被删除，说明这个ID可能一开始就不存在
+		c.JSON(http.StatusNotFound, gin.H{"error": "该报名记录不存在"})
+		return
 	}
+
+	// 4. 返回成功响应
+	c.JSON(http.StatusOK, gin.H{"message": "删除报名成功"})
 }
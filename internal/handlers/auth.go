@@ -2,22 +2,24 @@
 package handlers
 
 import (
-	"campus-activity-api/internal/config"
+	"campus-activity-api/internal/logging"
 	"campus-activity-api/internal/models"
-	"database/sql"
+	"campus-activity-api/internal/store"
+	"errors"
+	"math"
 	"net/http"
-	"strings"
+	"strconv"
 	"time"
 
-	"github.com/dgrijalva/jwt-go"
 	"github.com/gin-gonic/gin"
 	"golang.org/x/crypto/bcrypt"
 )
 
-var DB *sql.DB // 全局变量，由main.go注入，handlers整个包共享
+// 用户不存在时用于比较的 bcrypt 哈希，保证登录失败的耗时与密码错误时一致
+var dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("campus-activity-dummy-password"), bcrypt.DefaultCost)
 
 // 注册功能处理
-func Register(c *gin.Context) {
+func (h *Handler) Register(c *gin.Context) {
 	// 定义匿名结构体来接受前端传回来的json
 	var req struct {
 		Username string `json:"username"`
@@ -37,6 +39,10 @@ func Register(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "用户名至少4位，密码至少6位"})
 		return
 	}
+	if len(req.Password) > maxPasswordBytes {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "密码不能超过72字节"})
+		return
+	}
 
 	// 使用 bcrypt 库对密码进行不可逆哈希
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
@@ -45,20 +51,17 @@ func Register(c *gin.Context) {
 		return
 	}
 
-	// 将新用户插入数据库
-	stmt, err := DB.Prepare(
-		"INSERT INTO users(username, password_hash, full_name, college, role) VALUES(?, ?, ?, ?, 'student')")
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "数据库准备失败"})
-		return
-	}
-	defer stmt.Close()
-
-	// 将哈希之后的密码插入数据库
-	_, err = stmt.Exec(req.Username, string(hashedPassword), req.FullName, req.College)
+	// 将哈希之后的密码插入数据库，自助注册的用户一律为学生
+	err = h.Store.Users().Create(c.Request.Context(), &models.User{
+		Username:     req.Username,
+		PasswordHash: string(hashedPassword),
+		FullName:     req.FullName,
+		College:      req.College,
+		Role:         models.RoleStudent,
+	})
 	if err != nil {
-		// 检查是否是唯一键冲突错误 (用户名已存在)
-		if strings.Contains(err.Error(), "Duplicate entry") {
+		// 唯一键冲突说明用户名已存在
+		if errors.Is(err, store.ErrDuplicate) {
 			c.JSON(http.StatusConflict, gin.H{"error": "用户名已存在"})
 			return
 		}
@@ -70,7 +73,7 @@ func Register(c *gin.Context) {
 }
 
 // 登录处理
-func Login(c *gin.Context) {
+func (h *Handler) Login(c *gin.Context) {
 	// 定义匿名结构体来接受前端传回来的json
 	var req struct {
 		Username string `json:"username"`
@@ -83,48 +86,65 @@ func Login(c *gin.Context) {
 		return
 	}
 
-	// 从数据库中查询用户，这次需要包含 password_hash
-	var user models.User
+	// 1. 检查该用户名和 IP 是否处于退避或锁定期，并在校验密码前登记本次尝试
+	ctx := c.Request.Context()
+	ip := c.ClientIP()
+	if h.LoginGuard != nil {
+		wait, err := h.LoginGuard.Attempt(ctx, req.Username, ip)
+		if err != nil {
+			logging.FromContext(c.Request.Context()).Error("检查登录限制失败", "error", err)
+		}
+		if wait > 0 {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": "登录尝试过于频繁，请稍后再试", "code": "TOO_MANY_ATTEMPTS"})
+			return
+		}
+	}
 
-	// 查询语句中增加了 password_hash
-	err := DB.QueryRow(
-		"SELECT id, username, password_hash, full_name, college, role FROM users WHERE username = ?", 
-		req.Username).Scan(&user.ID, &user.Username, &user.PasswordHash, &user.FullName, &user.College, &user.Role)
+	// 从数据库中查询用户，包含 password_hash
+	user, err := h.Store.Users().GetByUsername(ctx, req.Username)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		logging.FromContext(c.Request.Context()).Error("查询用户失败", "error", err)
+	}
+	// 用户不存在时与一个固定哈希比较，使两种情况的响应时间一致
+	passwordHash := user.PasswordHash
 	if err != nil {
-		// 无论是用户不存在还是其他数据库错误，都返回统一的错误信息
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "用户名或密码错误"})
-		return
+		passwordHash = string(dummyPasswordHash)
 	}
 
 	// 使用 bcrypt 比较哈希值和用户输入的明文密码
 	// 第一个参数是从数据库取出的哈希值
 	// 第二个参数是用户在登录框输入的原始密码
-	err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.Password))
-	if err != nil {
-		// 如果 err 不为 nil，说明密码不匹配
+	if bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(req.Password)) != nil || err != nil {
+		// 无论是用户不存在、密码不匹配还是其他数据库错误，都返回统一的错误信息
+		// 登记尝试时已经按失败计入；数据库故障时撤销，避免故障期间把所有人锁定
+		if h.LoginGuard != nil && err != nil && !errors.Is(err, store.ErrNotFound) {
+			if err := h.LoginGuard.Abort(ctx, req.Username, ip); err != nil {
+				logging.FromContext(c.Request.Context()).Error("撤销登录尝试记录失败", "error", err)
+			}
+		}
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "用户名或密码错误"})
 		return
 	}
+	if h.LoginGuard != nil {
+		if err := h.LoginGuard.Success(ctx, req.Username, ip); err != nil {
+			logging.FromContext(c.Request.Context()).Error("清除登录失败记录失败", "error", err)
+		}
+	}
 
-	// 密码验证通过，生成JWT
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"id":       user.ID,
-		"username": user.Username,
-		"role":     user.Role,
-		// 过期时间设置为24小时
-		"exp":      time.Now().Add(time.Hour * 24).Unix(),
-	})
-
-	// 使用配置中的 secret 签名并获取完整的编码后的 token 字符串
-	tokenString, err := token.SignedString([]byte(config.Cfg.JWT.Secret))
+	// 密码验证通过，签发短期 access token 和 refresh token
+	s, _, err := h.issueSession(ctx, h.Store, user)
 	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("签发token失败", "error", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "无法生成token"})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"message": "登录成功",
-		"token":   tokenString,
+		"message":      "登录成功",
+		"token":        s.AccessToken,
+		"refreshToken": s.RefreshToken,
+		"expiresIn":    int(time.Until(s.AccessExpiresAt).Seconds()),
 		"user": gin.H{
 			"id":       user.ID,
 			"username": user.Username,
@@ -134,3 +154,32 @@ func Login(c *gin.Context) {
 		},
 	})
 }
+
+// 管理员解除某个用户的登录锁定
+func (h *Handler) AdminUnlockLogin(c *gin.Context) {
+	userID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的用户ID"})
+		return
+	}
+
+	user, err := h.Store.Users().Get(c.Request.Context(), userID)
+	if errors.Is(err, store.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "用户不存在", "code": "NOT_FOUND"})
+		return
+	}
+	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("查询用户失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "解除锁定失败"})
+		return
+	}
+
+	if h.LoginGuard != nil {
+		if err := h.LoginGuard.Unlock(c.Request.Context(), user.Username); err != nil {
+			logging.FromContext(c.Request.Context()).Error("解除登录锁定失败", "username", user.Username, "error", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "解除锁定失败"})
+			return
+		}
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "已解除该用户的登录锁定"})
+}
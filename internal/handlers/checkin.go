@@ -0,0 +1,256 @@
+// 现场签到模块：为审核通过的报名生成带签名的签到二维码，组织者扫码后记录签到时间
+package handlers
+
+import (
+	"campus-activity-api/internal/config"
+	"campus-activity-api/internal/logging"
+	"campus-activity-api/internal/models"
+	"campus-activity-api/internal/store"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/skip2/go-qrcode"
+)
+
+// 签到二维码图片的边长（像素）
+const checkinQRCodeSize = 256
+
+// 签到码无效：格式错误、签名不匹配或对应的报名记录不存在
+var ErrInvalidCheckinToken = errors.New("invalid check-in token")
+
+// 并发扫码时该报名已被其他请求签到
+var errAlreadyCheckedIn = errors.New("already checked in")
+
+// 签到码可用的签名密钥，第一个用于签名，全部可用于校验
+// 配置了 checkin.secret 时只使用它；否则使用 JWT 密钥，当前密钥排在最前，
+// 轮换 activeKeyId 后用旧密钥签发的签到码在旧密钥移除之前仍然有效
+func checkinSecrets() []string {
+	if config.Cfg.Checkin.Secret != "" {
+		return []string{config.Cfg.Checkin.Secret}
+	}
+	secrets := []string{config.Cfg.JWT.ActiveSecret()}
+	for _, key := range config.Cfg.JWT.Keys {
+		if key.ID != config.Cfg.JWT.ActiveKeyID {
+			secrets = append(secrets, key.Secret)
+		}
+	}
+	return secrets
+}
+
+// 使用任意一个可用密钥校验签到码签名，使用常量时间比较
+func verifyCheckinSignature(sig string, registrationID, activityID, userID int) bool {
+	for _, secret := range checkinSecrets() {
+		expected := checkinSignature(secret, registrationID, activityID, userID)
+		if hmac.Equal([]byte(sig), []byte(expected)) {
+			return true
+		}
+	}
+	return false
+}
+
+// 计算签到码签名，签名内容绑定报名ID、活动ID和用户ID
+func checkinSignature(secret string, registrationID, activityID, userID int) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "checkin:v1:%d:%d:%d", registrationID, activityID, userID)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// 生成签到码，格式为 "<报名ID>.<签名>"，内容尽量短以降低二维码密度
+func signCheckinToken(registrationID, activityID, userID int) string {
+	return strconv.Itoa(registrationID) + "." + checkinSignature(checkinSecrets()[0], registrationID, activityID, userID)
+}
+
+// 从签到码中解析出报名ID，签名需要结合数据库中的记录再校验
+func parseCheckinToken(token string) (int, string, error) {
+	idPart, sig, found := strings.Cut(token, ".")
+	if !found || sig == "" {
+		return 0, "", ErrInvalidCheckinToken
+	}
+	registrationID, err := strconv.Atoi(idPart)
+	if err != nil || registrationID <= 0 {
+		return 0, "", ErrInvalidCheckinToken
+	}
+	return registrationID, sig, nil
+}
+
+// 学生获取自己报名的签到二维码（PNG），只有审核通过的报名才能生成
+func (h *Handler) GetCheckinQRCode(c *gin.Context) {
+	registrationID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的报名ID"})
+		return
+	}
+
+	uid, _, ok := currentUser(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "用户未登录", "code": "UNAUTHORIZED"})
+		return
+	}
+
+	registration, err := h.Store.Registrations().Get(c.Request.Context(), registrationID)
+	// 不存在和不属于当前用户统一返回 404
+	if errors.Is(err, store.ErrNotFound) || (err == nil && registration.UserID != uid) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "该报名记录不存在", "code": "NOT_FOUND"})
+		return
+	}
+	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("查询报名记录失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "生成签到二维码失败"})
+		return
+	}
+	if registration.Status != models.RegistrationApproved {
+		c.JSON(http.StatusConflict, gin.H{"error": "报名审核通过后才能获取签到二维码", "code": "REGISTRATION_NOT_APPROVED"})
+		return
+	}
+
+	png, err := qrcode.Encode(signCheckinToken(registration.ID, registration.ActivityID, registration.UserID), qrcode.Medium, checkinQRCodeSize)
+	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("生成签到二维码失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "生成签到二维码失败"})
+		return
+	}
+	// 二维码与用户绑定，禁止中间缓存
+	c.Header("Cache-Control", "private, no-store")
+	c.Data(http.StatusOK, "image/png", png)
+}
+
+// 组织者扫码签到：校验签到码和签到时间窗口，记录签到时间，重复签到会被拒绝
+func (h *Handler) Checkin(c *gin.Context) {
+	activityID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的活动ID"})
+		return
+	}
+
+	uid, role, ok := currentUser(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "用户未登录", "code": "UNAUTHORIZED"})
+		return
+	}
+
+	var req struct {
+		Token string `json:"token" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "请求体无效, 需要 'token' 字段"})
+		return
+	}
+
+	// 1. 只有活动发布者或管理员可以为该活动签到
+	ctx := c.Request.Context()
+	err = authorizeActivityOwner(ctx, h.Store, activityID, uid, role)
+	switch {
+	case err == nil:
+	case errors.Is(err, ErrActivityNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "活动未找到", "code": "NOT_FOUND"})
+		return
+	case errors.Is(err, ErrNotActivityOwner):
+		c.JSON(http.StatusForbidden, gin.H{"error": "只有活动发布者或管理员可以签到", "code": "FORBIDDEN"})
+		return
+	default:
+		logging.FromContext(c.Request.Context()).Error("查询活动归属失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "签到失败"})
+		return
+	}
+
+	// 2. 解析签到码并读取对应报名记录
+	registrationID, sig, err := parseCheckinToken(req.Token)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "签到码无效", "code": "INVALID_CHECKIN_TOKEN"})
+		return
+	}
+	registration, err := h.Store.Registrations().Get(ctx, registrationID)
+	if errors.Is(err, store.ErrNotFound) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "签到码无效", "code": "INVALID_CHECKIN_TOKEN"})
+		return
+	}
+	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("查询报名记录失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "签到失败"})
+		return
+	}
+
+	// 3. 使用常量时间比较校验签名，防止伪造签到码
+	if !verifyCheckinSignature(sig, registrationID, registration.ActivityID, registration.UserID) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "签到码无效", "code": "INVALID_CHECKIN_TOKEN"})
+		return
+	}
+	if registration.ActivityID != activityID {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "该签到码不属于此活动", "code": "CHECKIN_ACTIVITY_MISMATCH"})
+		return
+	}
+	if registration.CheckedInAt != nil {
+		c.JSON(http.StatusConflict, gin.H{"error": "该用户已签到", "code": "ALREADY_CHECKED_IN", "checkedInAt": *registration.CheckedInAt})
+		return
+	}
+	if registration.Status != models.RegistrationApproved {
+		c.JSON(http.StatusConflict, gin.H{"error": "该报名未审核通过，不能签到", "code": "REGISTRATION_NOT_APPROVED"})
+		return
+	}
+
+	activity, err := h.Store.Activities().Get(ctx, activityID)
+	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("查询活动失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "签到失败"})
+		return
+	}
+	user, err := h.Store.Users().Get(ctx, registration.UserID)
+	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("查询用户失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "签到失败"})
+		return
+	}
+
+	// 4. 检查签到时间窗口
+	now := time.Now()
+	opensAt := activity.StartTime.Add(-time.Duration(config.Cfg.Checkin.OpenBeforeMinutes) * time.Minute)
+	closesAt := activity.EndTime.Add(time.Duration(config.Cfg.Checkin.CloseAfterMinutes) * time.Minute)
+	if now.Before(opensAt) {
+		c.JSON(http.StatusConflict, gin.H{"error": "签到尚未开始", "code": "CHECKIN_NOT_OPEN", "opensAt": opensAt})
+		return
+	}
+	if now.After(closesAt) {
+		c.JSON(http.StatusConflict, gin.H{"error": "签到已结束", "code": "CHECKIN_CLOSED", "closedAt": closesAt})
+		return
+	}
+
+	// 5. 在事务中记录签到时间并把报名状态流转为已签到，并发扫码时只有一次成功
+	err = h.Store.WithTx(ctx, func(tx store.Store) error {
+		marked, err := tx.Registrations().MarkCheckedIn(ctx, registrationID, now, uid)
+		if err != nil {
+			return err
+		}
+		if !marked {
+			return errAlreadyCheckedIn
+		}
+		return UpdateRegistrationStatus(ctx, tx, registrationID, models.RegistrationAttended, "现场签到", uid)
+	})
+	if errors.Is(err, errAlreadyCheckedIn) {
+		c.JSON(http.StatusConflict, gin.H{"error": "该用户已签到", "code": "ALREADY_CHECKED_IN"})
+		return
+	}
+	if status, body, ok := statusChangeErrorResponse(err); ok {
+		c.JSON(status, body)
+		return
+	}
+	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("记录签到失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "签到失败"})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.CheckinResult{
+		RegistrationID: registrationID,
+		UserID:         user.ID,
+		UserFullName:   user.FullName,
+		CheckedInAt:    now,
+	})
+}
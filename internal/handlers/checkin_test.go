@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"campus-activity-api/internal/config"
+	"testing"
+)
+
+// 轮换 JWT 当前密钥后，用旧密钥签发的签到码在旧密钥移除之前仍然有效
+func TestCheckinTokenSurvivesKeyRotation(t *testing.T) {
+	saved := config.Cfg
+	t.Cleanup(func() { config.Cfg = saved })
+	config.Cfg = &config.Config{JWT: config.JWTConfig{
+		Keys:        []config.JWTKey{{ID: "old", Secret: "old-secret"}, {ID: "new", Secret: "new-secret"}},
+		ActiveKeyID: "old",
+	}}
+
+	token := signCheckinToken(1, 2, 3)
+	config.Cfg.JWT.ActiveKeyID = "new"
+	id, sig, err := parseCheckinToken(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !verifyCheckinSignature(sig, id, 2, 3) {
+		t.Error("轮换后旧密钥签发的签到码应当仍然有效")
+	}
+	if verifyCheckinSignature(sig, id, 2, 4) {
+		t.Error("签名绑定的用户不同时应当无效")
+	}
+
+	config.Cfg.JWT.Keys = config.Cfg.JWT.Keys[1:]
+	if verifyCheckinSignature(sig, id, 2, 3) {
+		t.Error("旧密钥移除后签到码应当失效")
+	}
+}
@@ -0,0 +1,124 @@
+// 报名资格规则：按学院、用户名（学号）模式和角色限制可以报名的用户
+package handlers
+
+import (
+	"campus-activity-api/internal/models"
+	"strings"
+)
+
+// 不满足活动的报名资格，Reason 为面向用户的说明
+type EligibilityError struct {
+	Reason string
+}
+
+func (e *EligibilityError) Error() string {
+	return "not eligible for activity: " + e.Reason
+}
+
+// 校验并规范化资格规则：去掉首尾空白和重复项，nil 替换为空列表；返回空字符串表示校验通过
+func normalizeEligibility(rules *models.EligibilityRules) string {
+	rules.Colleges = normalizeRuleValues(rules.Colleges)
+	rules.UsernamePatterns = normalizeRuleValues(rules.UsernamePatterns)
+	rules.Roles = normalizeRuleValues(rules.Roles)
+
+	for _, pattern := range rules.UsernamePatterns {
+		if !isValidUsernamePattern(pattern) {
+			return "无效的用户名模式: " + pattern + "，只能包含字母、数字、'-'、'.' 和通配符 '*'"
+		}
+	}
+	for _, role := range rules.Roles {
+		switch role {
+		case models.RoleStudent, models.RoleOrganizer, models.RoleAdmin:
+		default:
+			return "无效的角色: " + role
+		}
+	}
+	return ""
+}
+
+func normalizeRuleValues(values []string) []string {
+	result := []string{}
+	seen := map[string]bool{}
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" || seen[v] {
+			continue
+		}
+		seen[v] = true
+		result = append(result, v)
+	}
+	return result
+}
+
+// 用户名模式只允许学号中常见的字符，这样模式可以直接转换为 SQL 的 LIKE 表达式而无需转义（见 store 包的 eligibleActivityCondition）
+func isValidUsernamePattern(pattern string) bool {
+	if len(pattern) > 100 {
+		return false
+	}
+	for _, r := range pattern {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '*', r == '-', r == '.':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// 判断用户名是否匹配模式，* 匹配任意长度的字符；与数据库排序规则一致，不区分大小写
+func matchUsernamePattern(pattern, username string) bool {
+	pattern = strings.ToLower(pattern)
+	username = strings.ToLower(username)
+
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == username
+	}
+	// 第一段必须是前缀，最后一段必须是后缀，中间各段按顺序出现
+	if !strings.HasPrefix(username, parts[0]) {
+		return false
+	}
+	username = username[len(parts[0]):]
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(username, part)
+		if i < 0 {
+			return false
+		}
+		username = username[i+len(part):]
+	}
+	return len(username) >= len(last) && strings.HasSuffix(username, last)
+}
+
+func containsFold(values []string, target string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// 检查用户是否满足活动的报名资格，不满足时返回说明原因的 *EligibilityError
+func checkEligibility(rules models.EligibilityRules, username, college, role string) error {
+	if len(rules.Colleges) > 0 && !containsFold(rules.Colleges, college) {
+		return &EligibilityError{Reason: "该活动仅限以下学院报名: " + strings.Join(rules.Colleges, "、")}
+	}
+	if len(rules.UsernamePatterns) > 0 {
+		matched := false
+		for _, pattern := range rules.UsernamePatterns {
+			if matchUsernamePattern(pattern, username) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return &EligibilityError{Reason: "你的学号不在该活动的报名范围内 (" + strings.Join(rules.UsernamePatterns, "、") + ")"}
+		}
+	}
+	if len(rules.Roles) > 0 && !containsFold(rules.Roles, role) {
+		return &EligibilityError{Reason: "该活动仅限以下身份报名: " + strings.Join(rules.Roles, "、")}
+	}
+	return nil
+}
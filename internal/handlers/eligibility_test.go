@@ -0,0 +1,43 @@
+package handlers
+
+import "testing"
+
+func TestMatchUsernamePattern(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		username string
+		want     bool
+	}{
+		{"20230001", "20230001", true},
+		{"20230001", "202300012", false},
+		// 前缀
+		{"2023*", "20230001", true},
+		{"2023*", "2023", true},
+		{"2023*", "20220001", false},
+		// 后缀
+		{"*01", "20230001", true},
+		{"*01", "20230010", false},
+		// 中间的 *
+		{"2023*01", "20230001", true},
+		{"2023*01", "202301", true},
+		{"2023*01", "2023001", true},
+		{"2023*01", "20230010", false},
+		// 前缀和后缀不能共用同一段字符
+		{"2023*3", "2023", false},
+		// 多个 *
+		{"20*3*1", "20230001", true},
+		{"20*3*1", "20220001", false},
+		{"*23*", "20230001", true},
+		{"**", "", true},
+		{"a*b*c", "abc", true},
+		{"a*b*c", "acb", false},
+		// 不区分大小写
+		{"CS2023*", "cs20230001", true},
+		{"cs*", "CS001", true},
+	}
+	for _, tt := range tests {
+		if got := matchUsernamePattern(tt.pattern, tt.username); got != tt.want {
+			t.Errorf("matchUsernamePattern(%q, %q) = %v, 期望 %v", tt.pattern, tt.username, got, tt.want)
+		}
+	}
+}
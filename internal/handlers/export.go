@@ -0,0 +1,191 @@
+// 导出报名数据：把某个活动的报名者按报名状态分工作表导出为 Excel 文件
+package handlers
+
+import (
+	"campus-activity-api/internal/logging"
+	"campus-activity-api/internal/models"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/xuri/excelize/v2"
+)
+
+// 报名状态工作表的先后顺序，工作表名为状态的中文名称
+var exportStatusOrder = []string{
+	models.RegistrationApproved,
+	models.RegistrationAttended,
+	models.RegistrationNoShow,
+	models.RegistrationPending,
+	models.RegistrationWaitlisted,
+	models.RegistrationRejected,
+	models.RegistrationCancelled,
+}
+
+// 导出表格的表头
+var exportHeaders = []interface{}{"用户名/学号", "姓名", "学院", "报名时间", "状态", "签到时间"}
+
+// 导出某个活动的报名者名单为 .xlsx，每种报名状态一个工作表
+func (h *Handler) ExportRegistrations(c *gin.Context) {
+	// 从 URL 中获取活动 ID
+	activityID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "活动ID无效"})
+		return
+	}
+
+	uid, role, ok := currentUser(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "用户未登录", "code": "UNAUTHORIZED"})
+		return
+	}
+
+	// 组织者只能导出自己发布的活动，管理员不受限制
+	err = authorizeActivityOwner(c.Request.Context(), h.Store, activityID, uid, role)
+	switch {
+	case err == nil:
+	case errors.Is(err, ErrActivityNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "活动未找到", "code": "NOT_FOUND"})
+		return
+	case errors.Is(err, ErrNotActivityOwner):
+		c.JSON(http.StatusForbidden, gin.H{"error": "只有活动发布者或管理员可以导出报名数据", "code": "FORBIDDEN"})
+		return
+	default:
+		logging.FromContext(c.Request.Context()).Error("查询活动归属失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "导出报名数据失败"})
+		return
+	}
+
+	registrants, err := h.Store.Registrations().ListByActivity(c.Request.Context(), activityID)
+	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("查询报名者信息失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "导出报名数据失败"})
+		return
+	}
+
+	f, err := buildRegistrationsWorkbook(registrants)
+	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("生成 Excel 文件失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "导出报名数据失败"})
+		return
+	}
+	defer f.Close()
+
+	// 以附件形式直接写入响应流
+	filename := fmt.Sprintf("activity_%d_registrations.xlsx", activityID)
+	c.Header("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	c.Status(http.StatusOK)
+	if err := f.Write(c.Writer); err != nil {
+		logging.FromContext(c.Request.Context()).Error("写出 Excel 文件失败", "error", err)
+	}
+}
+
+// 按报名状态把报名者分组写入工作簿，没有报名者时保留一个只有表头的工作表
+func buildRegistrationsWorkbook(registrants []models.RegistrationDetailsForActivity) (*excelize.File, error) {
+	f := excelize.NewFile()
+
+	// 按状态分组，未知状态直接用状态值作为工作表名
+	groups := map[string][]models.RegistrationDetailsForActivity{}
+	var extraStatuses []string
+	for _, reg := range registrants {
+		if _, seen := groups[reg.Status]; !seen && statusLabel(reg.Status) == reg.Status {
+			extraStatuses = append(extraStatuses, reg.Status)
+		}
+		groups[reg.Status] = append(groups[reg.Status], reg)
+	}
+
+	var sheets []string
+	for _, status := range exportStatusOrder {
+		if len(groups[status]) > 0 {
+			sheets = append(sheets, status)
+		}
+	}
+	sheets = append(sheets, extraStatuses...)
+
+	headerStyle, err := f.NewStyle(&excelize.Style{
+		Font:      &excelize.Font{Bold: true, Color: "FFFFFF"},
+		Fill:      excelize.Fill{Type: "pattern", Color: []string{"4472C4"}, Pattern: 1},
+		Alignment: &excelize.Alignment{Horizontal: "center", Vertical: "center"},
+	})
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	// 没有任何报名者时也返回一个带表头的空表
+	if len(sheets) == 0 {
+		if err := f.SetSheetName("Sheet1", "报名名单"); err != nil {
+			f.Close()
+			return nil, err
+		}
+		if err := writeRegistrationsSheet(f, "报名名单", headerStyle, nil); err != nil {
+			f.Close()
+			return nil, err
+		}
+		return f, nil
+	}
+
+	for i, status := range sheets {
+		name := statusLabel(status)
+		// 新建的工作簿自带 Sheet1，第一个状态直接复用
+		if i == 0 {
+			err = f.SetSheetName("Sheet1", name)
+		} else {
+			_, err = f.NewSheet(name)
+		}
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		if err := writeRegistrationsSheet(f, name, headerStyle, groups[status]); err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+	f.SetActiveSheet(0)
+	return f, nil
+}
+
+// 写入单个工作表：带样式的表头、冻结首行、数据行
+func writeRegistrationsSheet(f *excelize.File, sheet string, headerStyle int, rows []models.RegistrationDetailsForActivity) error {
+	if err := f.SetSheetRow(sheet, "A1", &exportHeaders); err != nil {
+		return err
+	}
+	if err := f.SetCellStyle(sheet, "A1", "F1", headerStyle); err != nil {
+		return err
+	}
+	if err := f.SetColWidth(sheet, "A", "F", 22); err != nil {
+		return err
+	}
+	if err := f.SetPanes(sheet, &excelize.Panes{
+		Freeze: true, YSplit: 1, TopLeftCell: "A2", ActivePane: "bottomLeft",
+	}); err != nil {
+		return err
+	}
+
+	for i, reg := range rows {
+		cell, err := excelize.CoordinatesToCellName(1, i+2)
+		if err != nil {
+			return err
+		}
+		checkedInAt := ""
+		if reg.CheckedInAt != nil {
+			checkedInAt = reg.CheckedInAt.Format("2006-01-02 15:04:05")
+		}
+		row := []interface{}{
+			reg.Username,
+			reg.UserFullName,
+			reg.UserCollege,
+			reg.RegistrationTime.Format("2006-01-02 15:04:05"),
+			statusLabel(reg.Status),
+			checkedInAt,
+		}
+		if err := f.SetSheetRow(sheet, cell, &row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
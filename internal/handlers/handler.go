@@ -0,0 +1,18 @@
+package handlers
+
+import (
+	"campus-activity-api/internal/auth"
+	"campus-activity-api/internal/health"
+	"campus-activity-api/internal/loginguard"
+	"campus-activity-api/internal/store"
+)
+
+// 所有 handler 共享的依赖，由 main.go 组装后注入
+// 测试时可以把 Store 换成内存实现
+type Handler struct {
+	Store       store.Store
+	Tokens      *auth.Manager
+	Revocations *auth.RevocationList // 为 nil 时只持久化吊销记录，不维护内存列表
+	LoginGuard  *loginguard.Guard    // 为 nil 时不限制登录尝试
+	Readiness   *health.Readiness    // 为 nil 时就绪检查总是通过
+}
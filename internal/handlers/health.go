@@ -0,0 +1,33 @@
+// 存活、就绪检查和构建信息，供容器平台探测使用，不需要登录
+package handlers
+
+import (
+	"campus-activity-api/internal/buildinfo"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 存活检查：进程能处理请求即返回 200，不检查依赖，避免数据库故障时容器被反复重启
+func (h *Handler) Healthz(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// 就绪检查：数据库可用且迁移已执行到最新时返回 200，否则返回 503；服务停止过程中总是返回 503
+func (h *Handler) Readyz(c *gin.Context) {
+	if h.Readiness == nil {
+		c.JSON(http.StatusOK, gin.H{"status": "ok"})
+		return
+	}
+	ready, checks := h.Readiness.Check(c.Request.Context())
+	if !ready {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"status": "unavailable", "code": "NOT_READY", "checks": checks})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok", "checks": checks})
+}
+
+// 构建信息：git commit、构建时间和 Go 版本
+func (h *Handler) Version(c *gin.Context) {
+	c.JSON(http.StatusOK, buildinfo.Get())
+}
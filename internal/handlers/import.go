@@ -0,0 +1,377 @@
+// 批量导入用户：管理员上传学院提供的 .xlsx / .csv 花名册，逐行校验后分批写入 users 表
+package handlers
+
+import (
+	"campus-activity-api/internal/logging"
+	"campus-activity-api/internal/models"
+	"campus-activity-api/internal/store"
+	"context"
+	"crypto/rand"
+	"encoding/csv"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/xuri/excelize/v2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 导入文件的限制
+const (
+	maxImportFileSize = 5 << 20 // 上传文件最大 5MB
+	maxImportRows     = 2000    // 单次最多导入的数据行数
+	// 导入请求的写超时：逐行计算 bcrypt 哈希较慢，服务默认的写超时可能在响应写出前断开连接，
+	// 管理员会拿不到系统生成的初始密码
+	importWriteTimeout = 5 * time.Minute
+)
+
+// 导入结果中每一行的状态
+const (
+	importRowCreated   = "created"
+	importRowDuplicate = "skipped_duplicate"
+	importRowInvalid   = "invalid"
+)
+
+// 表头别名 -> 字段，表头大小写和首尾空格不敏感
+var importHeaderAliases = map[string]string{
+	"username":  "username",
+	"用户名":       "username",
+	"学号":        "username",
+	"fullname":  "fullName",
+	"full_name": "fullName",
+	"姓名":        "fullName",
+	"college":   "college",
+	"学院":        "college",
+	"password":  "password",
+	"密码":        "password",
+	"初始密码":      "password",
+}
+
+// 生成初始密码使用的字符集，去掉了容易混淆的 0/O、1/l/I
+const initialPasswordAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz23456789"
+
+// 待导入的一行用户数据
+type importCandidate struct {
+	report       *models.UserImportRow
+	fullName     string
+	college      string
+	password     string
+	passwordHash string
+}
+
+// 管理员批量导入用户，dryRun=true 时只校验不写库
+func (h *Handler) ImportUsers(c *gin.Context) {
+	dryRun := c.Query("dryRun") == "true"
+
+	// 延长本次请求的写超时，保证导入结果（含初始密码）能完整返回
+	if err := http.NewResponseController(c.Writer).SetWriteDeadline(time.Now().Add(importWriteTimeout)); err != nil {
+		logging.FromContext(c.Request.Context()).Warn("无法延长导入请求的写超时", "error", err)
+	}
+
+	// 1. 读取上传文件
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "请通过 file 字段上传 .xlsx 或 .csv 文件"})
+		return
+	}
+	if fileHeader.Size > maxImportFileSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "文件不能超过 5MB"})
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无法读取上传的文件"})
+		return
+	}
+	defer file.Close()
+
+	// 2. 按扩展名解析为二维表格
+	var records [][]string
+	switch strings.ToLower(filepath.Ext(fileHeader.Filename)) {
+	case ".xlsx":
+		records, err = readXLSXRecords(file)
+	case ".csv":
+		records, err = readCSVRecords(file)
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "只支持 .xlsx 或 .csv 文件"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "文件解析失败: " + err.Error()})
+		return
+	}
+
+	// 3. 解析表头并逐行校验
+	report, candidates, err := parseImportRecords(records)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	report.DryRun = dryRun
+
+	// 4. 与数据库中已有的用户名比对
+	if err := h.markExistingUsernames(c.Request.Context(), candidates); err != nil {
+		logging.FromContext(c.Request.Context()).Error("查询已存在用户名失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "导入用户失败"})
+		return
+	}
+	var toCreate []*importCandidate
+	for _, cand := range candidates {
+		if cand.report.Status == importRowCreated {
+			toCreate = append(toCreate, cand)
+		}
+	}
+
+	// 5. 非演练模式下哈希密码并在事务中分批插入
+	if !dryRun && len(toCreate) > 0 {
+		if err := hashImportPasswords(toCreate); err != nil {
+			logging.FromContext(c.Request.Context()).Error("批量加密密码失败", "error", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "密码加密失败"})
+			return
+		}
+		if err := h.insertImportedUsers(c.Request.Context(), toCreate); err != nil {
+			if errors.Is(err, store.ErrDuplicate) {
+				c.JSON(http.StatusConflict, gin.H{"error": "导入期间有用户名被占用，请重新导入"})
+				return
+			}
+			logging.FromContext(c.Request.Context()).Error("批量插入用户失败", "error", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "导入用户失败"})
+			return
+		}
+	}
+
+	// 6. 汇总结果
+	for _, row := range report.Rows {
+		switch row.Status {
+		case importRowCreated:
+			report.Created++
+		case importRowDuplicate:
+			report.Skipped++
+		case importRowInvalid:
+			report.Invalid++
+		}
+	}
+	c.JSON(http.StatusOK, report)
+}
+
+// 读取 xlsx 第一个工作表的所有行
+func readXLSXRecords(r io.Reader) ([][]string, error) {
+	f, err := excelize.OpenReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	sheets := f.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, errors.New("工作簿中没有工作表")
+	}
+	return f.GetRows(sheets[0])
+}
+
+// 读取 csv 的所有行，允许每行列数不一致，并去掉 Excel 另存时带上的 UTF-8 BOM
+func readCSVRecords(r io.Reader) ([][]string, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) > 0 && len(records[0]) > 0 {
+		records[0][0] = strings.TrimPrefix(records[0][0], "\ufeff")
+	}
+	return records, nil
+}
+
+// 解析表头并逐行校验，返回每行的初步结果和可导入的候选行
+func parseImportRecords(records [][]string) (*models.UserImportReport, []*importCandidate, error) {
+	if len(records) == 0 {
+		return nil, nil, errors.New("文件为空")
+	}
+
+	// 表头 -> 列下标
+	columns := map[string]int{}
+	for i, name := range records[0] {
+		if field, ok := importHeaderAliases[strings.ToLower(strings.TrimSpace(name))]; ok {
+			columns[field] = i
+		}
+	}
+	for _, required := range []string{"username", "fullName", "college"} {
+		if _, ok := columns[required]; !ok {
+			return nil, nil, errors.New("缺少必需的列: " + required)
+		}
+	}
+	if len(records)-1 > maxImportRows {
+		return nil, nil, errors.New("单次最多导入 " + strconv.Itoa(maxImportRows) + " 行")
+	}
+
+	cell := func(record []string, field string) string {
+		i, ok := columns[field]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	// 预先分配好容量，保证 append 不会扩容，候选行持有的报告行指针始终有效
+	report := &models.UserImportReport{Rows: make([]models.UserImportRow, 0, len(records)-1)}
+	var candidates []*importCandidate
+	seen := map[string]bool{} // 小写的用户名，用户名唯一性不区分大小写
+	for i, record := range records[1:] {
+		// 跳过整行为空的记录
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+		report.Total++
+		row := models.UserImportRow{
+			// 行号按表格中显示的行号计算，表头为第 1 行
+			Row:      i + 2,
+			Username: cell(record, "username"),
+			Status:   importRowCreated,
+		}
+		cand := &importCandidate{
+			fullName: cell(record, "fullName"),
+			college:  cell(record, "college"),
+			password: cell(record, "password"),
+		}
+
+		// 与 Register 保持一致的校验规则，并受数据库字段长度限制
+		switch {
+		case utf8.RuneCountInString(row.Username) < 4 || utf8.RuneCountInString(row.Username) > 50:
+			row.Status, row.Reason = importRowInvalid, "用户名长度必须在4到50位之间"
+		case cand.fullName == "":
+			row.Status, row.Reason = importRowInvalid, "姓名不能为空"
+		case utf8.RuneCountInString(cand.fullName) > 50:
+			row.Status, row.Reason = importRowInvalid, "姓名不能超过50个字符"
+		case utf8.RuneCountInString(cand.college) > 100:
+			row.Status, row.Reason = importRowInvalid, "学院不能超过100个字符"
+		case cand.password != "" && len(cand.password) < minPasswordLength:
+			row.Status, row.Reason = importRowInvalid, "初始密码至少6位"
+		case len(cand.password) > maxPasswordBytes:
+			row.Status, row.Reason = importRowInvalid, "初始密码不能超过72字节"
+		case seen[strings.ToLower(row.Username)]:
+			row.Status, row.Reason = importRowDuplicate, "文件中用户名重复"
+		}
+		if row.Status != importRowInvalid {
+			seen[strings.ToLower(row.Username)] = true
+		}
+
+		report.Rows = append(report.Rows, row)
+		if row.Status == importRowCreated {
+			cand.report = &report.Rows[len(report.Rows)-1]
+			candidates = append(candidates, cand)
+		}
+	}
+	return report, candidates, nil
+}
+
+// 查询数据库中已存在的用户名，并把对应行标记为重复
+func (h *Handler) markExistingUsernames(ctx context.Context, candidates []*importCandidate) error {
+	usernames := make([]string, len(candidates))
+	for i, cand := range candidates {
+		usernames[i] = cand.report.Username
+	}
+	found, err := h.Store.Users().ExistingUsernames(ctx, usernames)
+	if err != nil {
+		return err
+	}
+	// 数据库中的用户名不区分大小写，返回的是库中原有的写法，按小写比对
+	existing := make(map[string]bool, len(found))
+	for username := range found {
+		existing[strings.ToLower(username)] = true
+	}
+	for _, cand := range candidates {
+		if existing[strings.ToLower(cand.report.Username)] {
+			cand.report.Status, cand.report.Reason = importRowDuplicate, "用户名已存在"
+		}
+	}
+	return nil
+}
+
+// 为每个候选行生成（如未提供）初始密码并计算 bcrypt 哈希
+// bcrypt 刻意设计得很慢，这里按 CPU 核数并发计算
+func hashImportPasswords(candidates []*importCandidate) error {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	sem := make(chan struct{}, runtime.NumCPU())
+	for _, cand := range candidates {
+		if cand.password == "" {
+			password, err := generateInitialPassword(10)
+			if err != nil {
+				// 不能直接返回，已启动的 goroutine 仍在写候选行，需要等它们结束
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+				break
+			}
+			cand.password = password
+			// 系统生成的密码需要返回给管理员下发
+			cand.report.InitialPassword = password
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(cand *importCandidate) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			hash, err := bcrypt.GenerateFromPassword([]byte(cand.password), bcrypt.DefaultCost)
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+				return
+			}
+			cand.passwordHash = string(hash)
+		}(cand)
+	}
+	wg.Wait()
+	return firstErr
+}
+
+// 在一个事务中分批插入用户，任意一批失败则全部回滚
+func (h *Handler) insertImportedUsers(ctx context.Context, candidates []*importCandidate) error {
+	users := make([]models.User, len(candidates))
+	for i, cand := range candidates {
+		users[i] = models.User{
+			Username:     cand.report.Username,
+			PasswordHash: cand.passwordHash,
+			FullName:     cand.fullName,
+			College:      cand.college,
+			Role:         models.RoleStudent,
+		}
+	}
+	return h.Store.WithTx(ctx, func(tx store.Store) error {
+		return tx.Users().CreateBatch(ctx, users)
+	})
+}
+
+// 使用 crypto/rand 生成指定长度的随机初始密码
+func generateInitialPassword(length int) (string, error) {
+	alphabetSize := big.NewInt(int64(len(initialPasswordAlphabet)))
+	buf := make([]byte, length)
+	for i := range buf {
+		n, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", err
+		}
+		buf[i] = initialPasswordAlphabet[n.Int64()]
+	}
+	return string(buf), nil
+}
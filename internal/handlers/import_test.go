@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"strings"
+	"testing"
+)
+
+// 超过 bcrypt 72 字节上限的初始密码只让该行无效，不影响其他行
+func TestParseImportRecordsPasswordTooLong(t *testing.T) {
+	records := [][]string{
+		{"username", "fullName", "college", "password"},
+		{"20230001", "张三", "计算机学院", strings.Repeat("密", 25)}, // 75 字节
+		{"20230002", "李四", "计算机学院", "secret123"},
+	}
+	report, candidates, err := parseImportRecords(records)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Rows[0].Status != importRowInvalid {
+		t.Errorf("过长密码的行状态为 %q, 期望 %q", report.Rows[0].Status, importRowInvalid)
+	}
+	if len(candidates) != 1 || candidates[0].report.Username != "20230002" {
+		t.Errorf("可导入的行不符: %d 行", len(candidates))
+	}
+}
+
+// 文件中只有大小写不同的用户名视为重复
+func TestParseImportRecordsDuplicateIgnoresCase(t *testing.T) {
+	records := [][]string{
+		{"username", "fullName", "college"},
+		{"Alice01", "爱丽丝", "外国语学院"},
+		{"alice01", "爱丽丝", "外国语学院"},
+	}
+	report, candidates, err := parseImportRecords(records)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Rows[1].Status != importRowDuplicate {
+		t.Errorf("大小写不同的重复行状态为 %q, 期望 %q", report.Rows[1].Status, importRowDuplicate)
+	}
+	if len(candidates) != 1 {
+		t.Errorf("可导入 %d 行, 期望 1 行", len(candidates))
+	}
+}
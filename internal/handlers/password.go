@@ -0,0 +1,207 @@
+// 密码管理：登录用户修改密码、管理员签发一次性重置令牌、凭令牌重置密码
+// 密码变更后吊销该用户的所有会话，需要重新登录
+package handlers
+
+import (
+	"campus-activity-api/internal/logging"
+	"campus-activity-api/internal/models"
+	"campus-activity-api/internal/store"
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 密码重置令牌的有效期
+const passwordResetTokenTTL = time.Hour
+
+// 密码最短长度，与注册时的要求一致
+const minPasswordLength = 6
+
+// 密码最大字节数，bcrypt 只接受不超过 72 字节的密码
+const maxPasswordBytes = 72
+
+// 重置令牌无效、已使用或已过期
+var ErrInvalidResetToken = errors.New("invalid password reset token")
+
+// 原密码不正确
+var errWrongPassword = errors.New("wrong password")
+
+// 在事务中更新用户密码，并吊销该用户的所有会话
+func (h *Handler) setPassword(ctx context.Context, tx store.Store, userID int, password string) error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	if err := tx.Users().UpdatePasswordHash(ctx, userID, string(hash)); err != nil {
+		return err
+	}
+	_, err = h.revokeAllSessions(ctx, tx, userID)
+	return err
+}
+
+// 当前用户修改自己的密码，需要提供原密码
+func (h *Handler) ChangePassword(c *gin.Context) {
+	uid, _, ok := currentUser(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "用户未登录", "code": "UNAUTHORIZED"})
+		return
+	}
+
+	var req struct {
+		OldPassword string `json:"oldPassword" binding:"required"`
+		NewPassword string `json:"newPassword" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "请求体无效, 需要 'oldPassword' 和 'newPassword' 字段"})
+		return
+	}
+	if len(req.NewPassword) < minPasswordLength {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "新密码至少6位"})
+		return
+	}
+	if len(req.NewPassword) > maxPasswordBytes {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "新密码不能超过72字节"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	err := h.Store.WithTx(ctx, func(tx store.Store) error {
+		// 1. 锁定用户行并校验原密码
+		user, err := tx.Users().GetForUpdate(ctx, uid)
+		if err != nil {
+			return err
+		}
+		if bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.OldPassword)) != nil {
+			return errWrongPassword
+		}
+
+		// 2. 更新密码并吊销所有会话
+		return h.setPassword(ctx, tx, uid, req.NewPassword)
+	})
+	switch {
+	case err == nil:
+	case errors.Is(err, store.ErrNotFound):
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "用户不存在", "code": "UNAUTHORIZED"})
+		return
+	case errors.Is(err, errWrongPassword):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "原密码错误", "code": "WRONG_PASSWORD"})
+		return
+	default:
+		logging.FromContext(c.Request.Context()).Error("修改密码失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "修改密码失败"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "密码修改成功，请重新登录"})
+}
+
+// 管理员为用户签发一次性的密码重置令牌，由管理员转交给用户
+// 同一用户之前未使用的令牌随之作废
+func (h *Handler) AdminIssuePasswordReset(c *gin.Context) {
+	userID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的用户ID"})
+		return
+	}
+	adminID, _, ok := currentUser(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "用户未登录", "code": "UNAUTHORIZED"})
+		return
+	}
+
+	token, err := randomToken(32)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "生成重置令牌失败"})
+		return
+	}
+	expiresAt := time.Now().Add(passwordResetTokenTTL)
+
+	ctx := c.Request.Context()
+	var user models.User
+	err = h.Store.WithTx(ctx, func(tx store.Store) error {
+		var err error
+		if user, err = tx.Users().Get(ctx, userID); err != nil {
+			return err
+		}
+		return tx.Sessions().ReplacePasswordResetToken(ctx, &store.PasswordResetToken{
+			UserID:      userID,
+			TokenHash:   hashToken(token),
+			ExpiresAt:   expiresAt,
+			CreatedByID: adminID,
+		})
+	})
+	if errors.Is(err, store.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "用户不存在", "code": "NOT_FOUND"})
+		return
+	}
+	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("生成密码重置令牌失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "生成重置令牌失败"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"userId":     userID,
+		"username":   user.Username,
+		"resetToken": token,
+		"expiresAt":  expiresAt,
+	})
+}
+
+// 凭重置令牌设置新密码，令牌只能使用一次
+func (h *Handler) ResetPassword(c *gin.Context) {
+	var req struct {
+		Token       string `json:"token" binding:"required"`
+		NewPassword string `json:"newPassword" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "请求体无效, 需要 'token' 和 'newPassword' 字段"})
+		return
+	}
+	if len(req.NewPassword) < minPasswordLength {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "新密码至少6位"})
+		return
+	}
+	if len(req.NewPassword) > maxPasswordBytes {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "新密码不能超过72字节"})
+		return
+	}
+
+	err := h.consumeResetToken(c.Request.Context(), req.Token, req.NewPassword)
+	if errors.Is(err, ErrInvalidResetToken) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "重置令牌无效或已过期", "code": "INVALID_RESET_TOKEN"})
+		return
+	}
+	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("重置密码失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "重置密码失败"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "密码重置成功，请使用新密码登录"})
+}
+
+func (h *Handler) consumeResetToken(ctx context.Context, token, newPassword string) error {
+	return h.Store.WithTx(ctx, func(tx store.Store) error {
+		// 锁定令牌记录，保证并发使用时只有一次成功
+		record, err := tx.Sessions().GetPasswordResetTokenForUpdate(ctx, hashToken(token))
+		if errors.Is(err, store.ErrNotFound) {
+			return ErrInvalidResetToken
+		}
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		if now.After(record.ExpiresAt) {
+			return ErrInvalidResetToken
+		}
+
+		if err := tx.Sessions().MarkPasswordResetTokenUsed(ctx, record.ID, now); err != nil {
+			return err
+		}
+		return h.setPassword(ctx, tx, record.UserID, newPassword)
+	})
+}
@@ -0,0 +1,282 @@
+// 报名状态机：定义报名状态之间允许的流转，统一处理状态变更、容量检查、状态历史记录和候补递补
+package handlers
+
+import (
+	"campus-activity-api/internal/logging"
+	"campus-activity-api/internal/models"
+	"campus-activity-api/internal/store"
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 每种状态允许流转到的目标状态，服务端据此拒绝非法的状态变更
+var registrationTransitions = map[string][]string{
+	models.RegistrationPending:    {models.RegistrationApproved, models.RegistrationRejected, models.RegistrationWaitlisted, models.RegistrationCancelled},
+	models.RegistrationWaitlisted: {models.RegistrationPending, models.RegistrationApproved, models.RegistrationRejected, models.RegistrationCancelled},
+	models.RegistrationApproved:   {models.RegistrationPending, models.RegistrationRejected, models.RegistrationCancelled, models.RegistrationAttended, models.RegistrationNoShow},
+	models.RegistrationRejected:   {models.RegistrationPending},
+	models.RegistrationCancelled:  {models.RegistrationPending},
+	// 签到结果允许互相更正
+	models.RegistrationAttended: {models.RegistrationNoShow},
+	models.RegistrationNoShow:   {models.RegistrationAttended},
+}
+
+// 占用活动名额的报名状态：待审核、已通过，以及活动结束后由已通过转入的已签到、未到场
+var seatHoldingStatusList = []string{
+	models.RegistrationPending,
+	models.RegistrationApproved,
+	models.RegistrationAttended,
+	models.RegistrationNoShow,
+}
+
+// 已审核通过的报名状态：已通过，以及由已通过转入的已签到、未到场；这些报名的人数任何时候都不能超过活动容量
+var approvedStatusList = []string{
+	models.RegistrationApproved,
+	models.RegistrationAttended,
+	models.RegistrationNoShow,
+}
+
+// 报名状态的中文名称
+var registrationStatusLabels = map[string]string{
+	models.RegistrationPending:    "待审核",
+	models.RegistrationApproved:   "已通过",
+	models.RegistrationWaitlisted: "候补",
+	models.RegistrationRejected:   "已拒绝",
+	models.RegistrationCancelled:  "已取消",
+	models.RegistrationAttended:   "已签到",
+	models.RegistrationNoShow:     "未到场",
+}
+
+// 修改报名状态相关的业务错误
+var (
+	ErrRegistrationNotFound = errors.New("registration not found")
+	ErrStatusReasonRequired = errors.New("reason is required for this status")
+)
+
+// 不允许的报名状态流转
+type StatusTransitionError struct {
+	From string
+	To   string
+}
+
+func (e *StatusTransitionError) Error() string {
+	return "registration status cannot change from '" + e.From + "' to '" + e.To + "'"
+}
+
+// 判断状态是否是合法的报名状态
+func isRegistrationStatus(status string) bool {
+	_, ok := registrationTransitions[status]
+	return ok
+}
+
+// 判断状态流转是否被允许
+func canTransition(from, to string) bool {
+	for _, next := range registrationTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// 判断该状态是否占用活动名额
+func holdsSeat(status string) bool {
+	for _, s := range seatHoldingStatusList {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// 判断该状态是否属于已审核通过
+func isApprovedStatus(status string) bool {
+	for _, s := range approvedStatusList {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// 报名状态的中文名称，未知状态原样返回
+func statusLabel(status string) string {
+	if label, ok := registrationStatusLabels[status]; ok {
+		return label
+	}
+	return status
+}
+
+// 在事务中修改报名状态
+// 按“活动行 -> 报名行”的顺序加锁，校验状态流转和拒绝原因；
+// 从不占名额的状态进入占名额的状态时检查容量，释放名额时递补候补名单，并记录状态历史
+func UpdateRegistrationStatus(ctx context.Context, tx store.Store, registrationID int, status, reason string, actorID int) error {
+	// 1. 查出所属活动并锁定活动行
+	registration, err := tx.Registrations().Get(ctx, registrationID)
+	if errors.Is(err, store.ErrNotFound) {
+		return ErrRegistrationNotFound
+	}
+	if err != nil {
+		return err
+	}
+	activity, err := tx.Activities().GetForUpdate(ctx, registration.ActivityID)
+	if err != nil {
+		return err
+	}
+
+	// 2. 锁定报名行并读取当前状态
+	registration, err = tx.Registrations().GetForUpdate(ctx, registrationID)
+	if errors.Is(err, store.ErrNotFound) {
+		return ErrRegistrationNotFound
+	}
+	if err != nil {
+		return err
+	}
+	from := registration.Status
+
+	// 3. 校验状态流转，拒绝报名必须填写原因
+	if !canTransition(from, status) {
+		return &StatusTransitionError{From: from, To: status}
+	}
+	reason = strings.TrimSpace(reason)
+	if status == models.RegistrationRejected && reason == "" {
+		return ErrStatusReasonRequired
+	}
+
+	// 4. 检查容量（0 为不限）：重新占用名额时，占名额的报名不能超过容量；
+	// 审核通过时，已通过的报名也不能超过容量（活动容量调低后，待审核的报名可能多于剩余名额）
+	if activity.Capacity > 0 {
+		if !holdsSeat(from) && holdsSeat(status) {
+			count, err := tx.Registrations().CountByStatus(ctx, activity.ID, seatHoldingStatusList)
+			if err != nil {
+				return err
+			}
+			if count >= activity.Capacity {
+				return ErrActivityFull
+			}
+		}
+		if !isApprovedStatus(from) && isApprovedStatus(status) {
+			count, err := tx.Registrations().CountByStatus(ctx, activity.ID, approvedStatusList)
+			if err != nil {
+				return err
+			}
+			if count >= activity.Capacity {
+				return ErrActivityFull
+			}
+		}
+	}
+
+	// 5. 更新状态并记录历史
+	if err := tx.Registrations().UpdateStatus(ctx, registrationID, status); err != nil {
+		return err
+	}
+	if err := tx.Registrations().RecordStatusChange(ctx, registrationID, from, status, reason, actorID); err != nil {
+		return err
+	}
+
+	// 6. 释放了名额则递补候补名单；改为候补的报名本身不参与这次递补
+	if holdsSeat(from) && !holdsSeat(status) {
+		if _, err := promoteFromWaitlist(ctx, tx, activity, registrationID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 把修改报名状态的业务错误映射为 HTTP 状态码和响应体，未知错误返回 false
+func statusChangeErrorResponse(err error) (int, gin.H, bool) {
+	var transitionErr *StatusTransitionError
+	switch {
+	case errors.As(err, &transitionErr):
+		return http.StatusConflict, gin.H{
+			"error": "报名状态不能从「" + statusLabel(transitionErr.From) + "」变更为「" + statusLabel(transitionErr.To) + "」",
+			"code":  "INVALID_STATUS_TRANSITION",
+		}, true
+	case errors.Is(err, ErrRegistrationNotFound):
+		return http.StatusNotFound, gin.H{"error": "该报名记录不存在", "code": "NOT_FOUND"}, true
+	case errors.Is(err, ErrStatusReasonRequired):
+		return http.StatusBadRequest, gin.H{"error": "拒绝报名必须填写原因", "code": "REASON_REQUIRED"}, true
+	case errors.Is(err, ErrActivityFull):
+		return http.StatusConflict, gin.H{"error": "活动报名人数已满", "code": "ACTIVITY_FULL"}, true
+	}
+	return 0, nil, false
+}
+
+// 用户取消报名：状态改为 cancelled 而不是删除，保留完整的状态历史
+// ownerID 不为 0 时只允许取消该用户自己的报名，并且受活动的取消截止时间限制；
+// ownerID 为 0（管理员代为取消）时不受截止时间限制。actorID 为操作人；返回值表示记录是否存在
+func CancelRegistrationByUser(ctx context.Context, s store.Store, registrationID, ownerID, actorID int) (bool, error) {
+	found := false
+	err := s.WithTx(ctx, func(tx store.Store) error {
+		registration, err := tx.Registrations().Get(ctx, registrationID)
+		// 不存在和不属于该用户统一按不存在处理
+		if errors.Is(err, store.ErrNotFound) || (err == nil && ownerID != 0 && registration.UserID != ownerID) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		found = true
+
+		// 未设置取消截止时间时，活动开始后不能再取消
+		if ownerID != 0 {
+			activity, err := tx.Activities().Get(ctx, registration.ActivityID)
+			if err != nil {
+				return err
+			}
+			cutoff := activity.StartTime
+			if activity.CancellationDeadline != nil {
+				cutoff = *activity.CancellationDeadline
+			}
+			if time.Now().After(cutoff) {
+				return ErrCancellationDeadlinePassed
+			}
+		}
+
+		return UpdateRegistrationStatus(ctx, tx, registrationID, models.RegistrationCancelled, "", actorID)
+	})
+	return found, err
+}
+
+// 查看某条报名记录的状态变更历史，只有报名者本人或管理员可以查看
+func (h *Handler) GetRegistrationHistory(c *gin.Context) {
+	registrationID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的报名ID"})
+		return
+	}
+
+	uid, role, ok := currentUser(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "用户未登录", "code": "UNAUTHORIZED"})
+		return
+	}
+
+	// 1. 校验报名记录归属，不存在和无权限统一返回 404
+	ctx := c.Request.Context()
+	registration, err := h.Store.Registrations().Get(ctx, registrationID)
+	if errors.Is(err, store.ErrNotFound) || (err == nil && registration.UserID != uid && role != models.RoleAdmin) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "该报名记录不存在", "code": "NOT_FOUND"})
+		return
+	}
+	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("查询报名记录失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "查询状态历史失败"})
+		return
+	}
+
+	// 2. 按时间顺序返回状态历史
+	history, err := h.Store.Registrations().History(ctx, registrationID)
+	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("查询状态历史失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "查询状态历史失败"})
+		return
+	}
+	c.JSON(http.StatusOK, history)
+}
@@ -0,0 +1,239 @@
+// 登录会话管理：短期 access token + 可轮换的 refresh token，支持退出登录和管理员强制下线
+package handlers
+
+import (
+	"campus-activity-api/internal/auth"
+	"campus-activity-api/internal/config"
+	"campus-activity-api/internal/logging"
+	"campus-activity-api/internal/models"
+	"campus-activity-api/internal/store"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// refresh token 无效、过期或已被使用
+var ErrInvalidRefreshToken = errors.New("invalid refresh token")
+
+// 一次登录（或刷新）签发的一对 token
+type session struct {
+	AccessToken     string
+	AccessExpiresAt time.Time
+	RefreshToken    string
+}
+
+// 生成指定字节数的随机串，使用 URL 安全的 base64 编码
+func randomToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// refresh token 和密码重置令牌在数据库中只保存 SHA-256 摘要，数据库泄露也无法直接使用
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// 为用户签发新的 access token 和 refresh token，返回 refresh token 记录的 ID
+func (h *Handler) issueSession(ctx context.Context, s store.Store, user models.User) (session, int, error) {
+	accessToken, claims, err := h.Tokens.Issue(user.ID, user.Username, user.Role)
+	if err != nil {
+		return session{}, 0, err
+	}
+	accessExpiresAt := claims.ExpiresAt.Time
+
+	refreshToken, err := randomToken(32)
+	if err != nil {
+		return session{}, 0, err
+	}
+	// 记录配对的 access token，强制下线时据此吊销仍在有效期内的 access token
+	record := store.RefreshToken{
+		UserID:          user.ID,
+		TokenHash:       hashToken(refreshToken),
+		AccessJTI:       claims.ID,
+		AccessExpiresAt: accessExpiresAt,
+		ExpiresAt:       time.Now().AddDate(0, 0, config.Cfg.JWT.RefreshTokenDays),
+	}
+	if err := s.Sessions().CreateRefreshToken(ctx, &record); err != nil {
+		return session{}, 0, err
+	}
+	return session{AccessToken: accessToken, AccessExpiresAt: accessExpiresAt, RefreshToken: refreshToken}, record.ID, nil
+}
+
+// 签发 token 的统一响应，token 字段保持与旧版登录接口兼容
+func sessionResponse(s session) gin.H {
+	return gin.H{
+		"token":        s.AccessToken,
+		"refreshToken": s.RefreshToken,
+		"expiresIn":    int(time.Until(s.AccessExpiresAt).Seconds()),
+	}
+}
+
+// 吊销一个 access token：持久化后立即加入内存中的吊销列表
+func (h *Handler) revokeAccessToken(ctx context.Context, s store.Store, jti string, userID int, expiresAt time.Time) error {
+	// 已过期的 token 本身就无效，无需记录
+	if jti == "" || !expiresAt.After(time.Now()) {
+		return nil
+	}
+	if err := s.Sessions().RevokeAccessToken(ctx, jti, userID, expiresAt); err != nil {
+		return err
+	}
+	if h.Revocations != nil {
+		h.Revocations.Add(jti, expiresAt)
+	}
+	return nil
+}
+
+// 在事务中吊销用户的所有会话：作废所有 refresh token，并把仍在有效期内的 access token 加入吊销列表
+// 返回被作废的 refresh token 数量
+func (h *Handler) revokeAllSessions(ctx context.Context, tx store.Store, userID int) (int, error) {
+	now := time.Now()
+	tokens, err := tx.Sessions().ActiveAccessTokens(ctx, userID, now)
+	if err != nil {
+		return 0, err
+	}
+	for _, t := range tokens {
+		if err := h.revokeAccessToken(ctx, tx, t.JTI, userID, t.ExpiresAt); err != nil {
+			return 0, err
+		}
+	}
+	return tx.Sessions().RevokeRefreshTokens(ctx, userID, now)
+}
+
+// 用 refresh token 换取新的一对 token，旧的 refresh token 随即作废（轮换）
+// 已作废的 refresh token 再次出现说明可能被盗用，此时吊销该用户的所有会话
+func (h *Handler) RefreshToken(c *gin.Context) {
+	var req struct {
+		RefreshToken string `json:"refreshToken" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "请求体无效, 需要 'refreshToken' 字段"})
+		return
+	}
+
+	s, err := h.rotateRefreshToken(c.Request.Context(), req.RefreshToken)
+	if errors.Is(err, ErrInvalidRefreshToken) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "登录已失效，请重新登录", "code": "INVALID_REFRESH_TOKEN"})
+		return
+	}
+	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("刷新 token 失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "刷新 token 失败"})
+		return
+	}
+	c.JSON(http.StatusOK, sessionResponse(s))
+}
+
+func (h *Handler) rotateRefreshToken(ctx context.Context, refreshToken string) (session, error) {
+	var s session
+	reused := false
+	err := h.Store.WithTx(ctx, func(tx store.Store) error {
+		// 1. 锁定 refresh token 记录，防止同一个 token 被并发使用两次
+		record, err := tx.Sessions().GetRefreshTokenForUpdate(ctx, hashToken(refreshToken))
+		if errors.Is(err, store.ErrNotFound) {
+			return ErrInvalidRefreshToken
+		}
+		if err != nil {
+			return err
+		}
+
+		// 2. 已作废的 token 被重复使用，吊销该用户的所有会话；这些变更需要提交，事务结束后再返回错误
+		if record.RevokedAt != nil {
+			logging.FromContext(ctx).Warn("检测到已作废的 refresh token 被重复使用, 吊销该用户的所有会话", "target_user_id", record.UserID)
+			reused = true
+			_, err := h.revokeAllSessions(ctx, tx, record.UserID)
+			return err
+		}
+		if time.Now().After(record.ExpiresAt) {
+			return ErrInvalidRefreshToken
+		}
+
+		// 3. 重新读取用户信息，角色变更后新 token 立即生效
+		user, err := tx.Users().Get(ctx, record.UserID)
+		if errors.Is(err, store.ErrNotFound) {
+			return ErrInvalidRefreshToken
+		}
+		if err != nil {
+			return err
+		}
+
+		// 4. 签发新 token，并把旧记录标记为已被替换
+		var newID int
+		s, newID, err = h.issueSession(ctx, tx, user)
+		if err != nil {
+			return err
+		}
+		return tx.Sessions().MarkRefreshTokenReplaced(ctx, record.ID, newID, time.Now())
+	})
+	if err == nil && reused {
+		err = ErrInvalidRefreshToken
+	}
+	return s, err
+}
+
+// 退出登录：吊销当前 access token 以及与之配对的 refresh token
+func (h *Handler) Logout(c *gin.Context) {
+	user, ok := auth.CurrentUser(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "用户未登录", "code": "UNAUTHORIZED"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	err := h.Store.WithTx(ctx, func(tx store.Store) error {
+		if err := tx.Sessions().RevokeRefreshTokenByAccessJTI(ctx, user.UserID, user.ID, time.Now()); err != nil {
+			return err
+		}
+		return h.revokeAccessToken(ctx, tx, user.ID, user.UserID, user.ExpiresAt.Time)
+	})
+	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("退出登录失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "退出登录失败"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "已退出登录"})
+}
+
+// 管理员强制下线某个用户：吊销该用户的所有会话
+func (h *Handler) AdminRevokeUserSessions(c *gin.Context) {
+	userID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的用户ID"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	if _, err := h.Store.Users().Get(ctx, userID); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "用户不存在", "code": "NOT_FOUND"})
+			return
+		}
+		logging.FromContext(c.Request.Context()).Error("查询用户失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "吊销会话失败"})
+		return
+	}
+
+	var revoked int
+	err = h.Store.WithTx(ctx, func(tx store.Store) error {
+		var err error
+		revoked, err = h.revokeAllSessions(ctx, tx, userID)
+		return err
+	})
+	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("吊销用户会话失败", "target_user_id", userID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "吊销会话失败"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "已吊销该用户的所有会话", "revoked": revoked})
+}
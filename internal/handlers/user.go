@@ -2,121 +2,276 @@
 package handlers
 
 import (
+	"campus-activity-api/internal/logging"
 	"campus-activity-api/internal/models"
-	"database/sql"
-	"log"
+	"campus-activity-api/internal/store"
+	"context"
+	"errors"
 	"net/http"
 	"strconv"
-	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
 // 获取用户报名的所有活动
-func GetMyActivities(c *gin.Context) {
+func (h *Handler) GetMyActivities(c *gin.Context) {
 	// 从 url 参数中获取用户id
-	userID := c.Param("id")
-	// sql查询
-	query := `
-	SELECT r.id, a.id, a.title, a.location, a.start_time 
-	FROM registrations r 
-	JOIN activities a 
-	ON r.activity_id = a.id 
-	WHERE r.user_id = ? 
-	ORDER BY a.start_time DESC`
-	rows, err := DB.Query(query, userID)
+	userID, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "查询我的活动失败"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的用户ID"})
 		return
 	}
-	defer rows.Close()
 
-	// 创建一个 []models.UserRegistration 切片存储结果，返回到前端页面
-	registrations := []models.UserRegistration{}
-	for rows.Next() {
-		var reg models.UserRegistration
-		if err := rows.Scan(&reg.RegistrationID, &reg.ActivityID, &reg.Title, &reg.Location, &reg.StartTime); err != nil {
-			log.Println("扫描我的活动数据失败:", err)
-			continue
-		}
-		registrations = append(registrations, reg)
+	// 学生只能查看自己的报名，管理员可以查看任意用户
+	uid, role, ok := currentUser(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "用户未登录", "code": "UNAUTHORIZED"})
+		return
+	}
+	if uid != userID && role != models.RoleAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "无权查看其他用户的报名信息", "code": "FORBIDDEN"})
+		return
+	}
+
+	registrations, err := h.Store.Registrations().ListByUser(c.Request.Context(), userID)
+	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("查询我的活动失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "查询我的活动失败"})
+		return
 	}
 	c.JSON(http.StatusOK, registrations)
 }
 
-// 处理“用户报名活动”的请求
-func RegisterForActivityHandler(db *sql.DB) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		// 从 URL 获取活动 ID
-		activityID, err := strconv.Atoi(c.Param("id"))
+// 报名相关的业务错误，由 handler 映射为对应的 HTTP 响应
+var (
+	ErrActivityNotFound  = errors.New("activity not found")
+	ErrActivityFull      = errors.New("activity is full")
+	ErrAlreadyRegistered = errors.New("already registered for activity")
+
+	ErrRegistrationNotOpen        = errors.New("registration is not open yet")
+	ErrRegistrationClosed         = errors.New("registration is closed")
+	ErrCancellationDeadlinePassed = errors.New("cancellation deadline has passed")
+)
+
+// 在事务中为用户创建报名记录，返回新记录的状态
+// 先锁住活动行，同一活动的并发报名会在这里排队，
+// 再统计实时报名人数与 capacity 比较（0 为不限），保证不会超额报名。
+// 活动已满时，joinWaitlist 为 true 则以 waitlisted 状态加入候补名单，否则返回 ErrActivityFull
+func CreateRegistration(ctx context.Context, s store.Store, userID, activityID int, joinWaitlist bool) (string, error) {
+	var status string
+	err := s.WithTx(ctx, func(tx store.Store) error {
+		var err error
+		status, err = createRegistration(ctx, tx, userID, activityID, joinWaitlist)
+		return err
+	})
+	return status, err
+}
+
+func createRegistration(ctx context.Context, tx store.Store, userID, activityID int, joinWaitlist bool) (string, error) {
+	// 1. 锁定活动行，读取容量、审核策略、报名时间窗口和资格规则
+	activity, err := tx.Activities().GetForUpdate(ctx, activityID)
+	if errors.Is(err, store.ErrNotFound) {
+		return "", ErrActivityNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	// 只能在报名窗口内报名，未设置截止时间时以活动结束时间为准
+	now := time.Now()
+	if activity.RegistrationOpensAt != nil && now.Before(*activity.RegistrationOpensAt) {
+		return "", ErrRegistrationNotOpen
+	}
+	closesAt := activity.EndTime
+	if activity.RegistrationClosesAt != nil {
+		closesAt = *activity.RegistrationClosesAt
+	}
+	if now.After(closesAt) {
+		return "", ErrRegistrationClosed
+	}
+
+	// 2. 检查是否已经报名过，避免活动满员时给已报名的用户返回“已满”
+	// 已取消的报名允许重新报名，复用原记录以保留状态历史
+	existing, err := tx.Registrations().FindForUpdate(ctx, userID, activityID)
+	switch {
+	case errors.Is(err, store.ErrNotFound):
+		existing = models.Registration{}
+	case err != nil:
+		return "", err
+	case existing.Status != models.RegistrationCancelled:
+		return "", ErrAlreadyRegistered
+	}
+
+	// 3. 检查报名资格（学院、学号模式、角色）
+	user, err := tx.Users().Get(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if err := checkEligibility(activity.Eligibility, user.Username, user.College, user.Role); err != nil {
+		return "", err
+	}
+
+	// 4. 按活动的审核策略决定初始状态；有容量限制时统计当前占用名额的报名数，满员则进入候补
+	status := initialRegistrationStatus(activity.ApprovalPolicy, activity.AutoApproveColleges, user.College)
+	if activity.Capacity > 0 {
+		count, err := tx.Registrations().CountByStatus(ctx, activityID, seatHoldingStatusList)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "无效的活动ID"})
-			return
+			return "", err
 		}
-
-		// 从认证中间件获取用户ID (标准做法)
-		userID, exists := c.Get("userID")
-		if !exists {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "用户未登录"})
-			return
+		if count >= activity.Capacity {
+			if !joinWaitlist {
+				return "", ErrActivityFull
+			}
+			status = models.RegistrationWaitlisted
 		}
+	}
 
-		// 类型断言，json对象在Go中被解码到interface{}时，数字类型会变成float64
-		uid, ok := userID.(float64)
-		if !ok {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "无法解析用户ID"})
-			return
+	// 5. 重新报名时重置报名时间和签到信息，候补排序以重新报名的时间为准
+	if existing.ID != 0 {
+		if err := tx.Registrations().Reopen(ctx, existing.ID, status, now); err != nil {
+			return "", err
 		}
-
-		// 1. 创建一个 Registration 对象
-		registration := models.Registration{
-			UserID:     int(uid),
-			ActivityID: activityID,
-			Status:     "pending",
-			// RegistrationTime: time.Now(), // 在数据库层面自动生成
+		if err := tx.Registrations().RecordStatusChange(ctx, existing.ID, existing.Status, status, "重新报名"+approvalNote(status), userID); err != nil {
+			return "", err
 		}
+		return status, nil
+	}
 
-		// 2. 准备插入语句
-		query := "INSERT INTO registrations (user_id, activity_id, status) VALUES (?, ?, ?)"
+	// 6. 插入报名记录
+	registration := models.Registration{
+		UserID:           userID,
+		ActivityID:       activityID,
+		RegistrationTime: now,
+		Status:           status,
+	}
+	if err := tx.Registrations().Create(ctx, &registration); err != nil {
+		// 唯一索引兜底，防止锁之外的重复报名
+		if errors.Is(err, store.ErrDuplicate) {
+			return "", ErrAlreadyRegistered
+		}
+		return "", err
+	}
+	if err := tx.Registrations().RecordStatusChange(ctx, registration.ID, "", status, approvalNote(status), userID); err != nil {
+		return "", err
+	}
+	return status, nil
+}
 
-		// 3. 执行插入操作
-		_, err = db.Exec(query, registration.UserID, registration.ActivityID, registration.Status)
-		if err != nil {
-			// 处理可能的错误，比如重复报名
-			if strings.Contains(err.Error(), "Duplicate entry") {
-				c.JSON(http.StatusConflict, gin.H{"error": "你已经报名过该活动"})
-				return
+// 根据活动的审核策略计算报名（或候补递补）后的状态，调用方负责名额检查
+func initialRegistrationStatus(policy string, autoApproveColleges []string, userCollege string) string {
+	switch policy {
+	case models.ApprovalAuto:
+		return models.RegistrationApproved
+	case models.ApprovalAutoColleges:
+		for _, college := range autoApproveColleges {
+			if college == userCollege {
+				return models.RegistrationApproved
 			}
-			log.Printf("数据库插入报名记录失败: %v", err) // 记录详细错误
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "报名失败，服务器错误"})
-			return
 		}
+	}
+	return models.RegistrationPending
+}
 
-		c.JSON(http.StatusCreated, gin.H{"message": "报名成功，请等待管理员审核"})
+// 自动审核通过时写入状态历史的说明
+func approvalNote(status string) string {
+	if status == models.RegistrationApproved {
+		return "自动审核通过"
 	}
+	return ""
+}
+
+// 处理“用户报名活动”的请求
+func (h *Handler) RegisterForActivity(c *gin.Context) {
+	// 从 URL 获取活动 ID
+	activityID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的活动ID"})
+		return
+	}
+
+	// 从认证中间件获取用户ID
+	uid, _, ok := currentUser(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "用户未登录", "code": "UNAUTHORIZED"})
+		return
+	}
+
+	// 在事务中检查容量并插入报名记录，初始状态由活动的审核策略决定
+	var eligibilityErr *EligibilityError
+	status, err := CreateRegistration(c.Request.Context(), h.Store, uid, activityID, false)
+	switch {
+	case err == nil:
+	case errors.Is(err, ErrActivityNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "活动未找到"})
+		return
+	case errors.Is(err, ErrAlreadyRegistered):
+		c.JSON(http.StatusConflict, gin.H{"error": "你已经报名过该活动"})
+		return
+	case errors.Is(err, ErrActivityFull):
+		c.JSON(http.StatusConflict, gin.H{"error": "活动报名人数已满，可加入候补名单", "code": "ACTIVITY_FULL"})
+		return
+	case errors.Is(err, ErrRegistrationNotOpen):
+		c.JSON(http.StatusConflict, gin.H{"error": "活动尚未开放报名", "code": "REGISTRATION_NOT_OPEN"})
+		return
+	case errors.Is(err, ErrRegistrationClosed):
+		c.JSON(http.StatusConflict, gin.H{"error": "活动报名已截止", "code": "REGISTRATION_CLOSED"})
+		return
+	case errors.As(err, &eligibilityErr):
+		c.JSON(http.StatusForbidden, gin.H{"error": eligibilityErr.Reason, "code": "NOT_ELIGIBLE"})
+		return
+	default:
+		logging.FromContext(c.Request.Context()).Error("数据库插入报名记录失败", "error", err) // 记录详细错误
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "报名失败，服务器错误"})
+		return
+	}
+
+	if status == models.RegistrationApproved {
+		c.JSON(http.StatusCreated, gin.H{"message": "报名成功", "status": status})
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"message": "报名成功，请等待管理员审核", "status": status})
 }
 
 // 用户取消活动报名处理
-func CancelRegistration(c *gin.Context) {
+func (h *Handler) CancelRegistration(c *gin.Context) {
 	// 从 url 参数中获取报名ID
-	registrationID := c.Param("id")
-
-	// 准备删除语句
-	stmt, err := DB.Prepare("DELETE FROM registrations WHERE id = ?")
+	registrationID, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "取消报名失败"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的报名ID"})
 		return
 	}
 
-	defer stmt.Close()
-
-	// 执行删除操作
-	_, err = stmt.Exec(registrationID)
+	uid, role, ok := currentUser(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "用户未登录", "code": "UNAUTHORIZED"})
+		return
+	}
+	// 学生只能取消自己的报名，管理员不受限制
+	ownerID := uid
+	if role == models.RoleAdmin {
+		ownerID = 0
+	}
 
-	// 检查删除是否成功
+	// 报名状态改为已取消，释放名额时自动递补候补名单
+	found, err := CancelRegistrationByUser(c.Request.Context(), h.Store, registrationID, ownerID, uid)
+	if errors.Is(err, ErrCancellationDeadlinePassed) {
+		c.JSON(http.StatusConflict, gin.H{"error": "已超过取消报名的截止时间", "code": "CANCELLATION_DEADLINE_PASSED"})
+		return
+	}
+	if status, body, ok := statusChangeErrorResponse(err); ok {
+		c.JSON(status, body)
+		return
+	}
 	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("取消报名失败", "error", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "执行取消报名失败"})
 		return
 	}
+	if !found {
+		// 不存在和不属于当前用户统一返回 404，不泄露记录是否存在
+		c.JSON(http.StatusNotFound, gin.H{"error": "该报名记录不存在", "code": "NOT_FOUND"})
+		return
+	}
 	c.JSON(http.StatusOK, gin.H{"message": "取消报名成功"})
 }
@@ -0,0 +1,186 @@
+// 候补名单模块：活动满员后加入候补、查询候补位次，以及名额释放后按报名时间自动递补
+package handlers
+
+import (
+	"campus-activity-api/internal/logging"
+	"campus-activity-api/internal/models"
+	"campus-activity-api/internal/store"
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 用户加入活动候补名单，活动未满时直接按普通报名处理
+func (h *Handler) JoinWaitlist(c *gin.Context) {
+	// 从 URL 获取活动 ID
+	activityID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的活动ID"})
+		return
+	}
+
+	// 从认证中间件获取用户ID
+	uid, _, ok := currentUser(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "用户未登录", "code": "UNAUTHORIZED"})
+		return
+	}
+
+	var eligibilityErr *EligibilityError
+	status, err := CreateRegistration(c.Request.Context(), h.Store, uid, activityID, true)
+	switch {
+	case err == nil:
+	case errors.Is(err, ErrActivityNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "活动未找到"})
+		return
+	case errors.Is(err, ErrAlreadyRegistered):
+		c.JSON(http.StatusConflict, gin.H{"error": "你已经报名过该活动"})
+		return
+	case errors.Is(err, ErrRegistrationNotOpen):
+		c.JSON(http.StatusConflict, gin.H{"error": "活动尚未开放报名", "code": "REGISTRATION_NOT_OPEN"})
+		return
+	case errors.Is(err, ErrRegistrationClosed):
+		c.JSON(http.StatusConflict, gin.H{"error": "活动报名已截止", "code": "REGISTRATION_CLOSED"})
+		return
+	case errors.As(err, &eligibilityErr):
+		c.JSON(http.StatusForbidden, gin.H{"error": eligibilityErr.Reason, "code": "NOT_ELIGIBLE"})
+		return
+	default:
+		logging.FromContext(c.Request.Context()).Error("加入候补名单失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "加入候补名单失败，服务器错误"})
+		return
+	}
+
+	switch status {
+	case models.RegistrationWaitlisted:
+		c.JSON(http.StatusCreated, gin.H{"message": "活动已满，已加入候补名单", "status": status})
+		return
+	case models.RegistrationApproved:
+		c.JSON(http.StatusCreated, gin.H{"message": "报名成功", "status": status})
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"message": "报名成功，请等待管理员审核", "status": status})
+}
+
+// 查询当前用户在某活动候补名单中的位次
+func (h *Handler) GetWaitlistPosition(c *gin.Context) {
+	activityID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "无效的活动ID"})
+		return
+	}
+
+	uid, _, ok := currentUser(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "用户未登录", "code": "UNAUTHORIZED"})
+		return
+	}
+
+	// 按报名时间排序计算位次
+	pos, err := h.Store.Registrations().WaitlistPosition(c.Request.Context(), uid, activityID)
+	if errors.Is(err, store.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "你不在该活动的候补名单中"})
+		return
+	}
+	if err != nil {
+		logging.FromContext(c.Request.Context()).Error("查询候补位次失败", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "查询候补位次失败"})
+		return
+	}
+
+	c.JSON(http.StatusOK, pos)
+}
+
+// 删除一条报名记录，若被删除的记录占用着名额，则在同一事务中递补候补名单
+// 返回值 deleted 表示记录是否存在并被删除
+func DeleteRegistrationAndPromote(ctx context.Context, s store.Store, registrationID int) (bool, error) {
+	deleted := false
+	err := s.WithTx(ctx, func(tx store.Store) error {
+		// 1. 先查出所属活动，再按“活动行 -> 报名行”的顺序加锁，与报名流程的加锁顺序保持一致
+		registration, err := tx.Registrations().Get(ctx, registrationID)
+		if errors.Is(err, store.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		activity, err := tx.Activities().GetForUpdate(ctx, registration.ActivityID)
+		if err != nil {
+			return err
+		}
+
+		// 2. 加锁后重新读取状态，防止期间被审核修改
+		registration, err = tx.Registrations().GetForUpdate(ctx, registrationID)
+		if errors.Is(err, store.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// 3. 删除记录
+		if err := tx.Registrations().Delete(ctx, registrationID); err != nil {
+			return err
+		}
+		deleted = true
+
+		// 4. 只有释放了名额才需要递补
+		if holdsSeat(registration.Status) {
+			if _, err := promoteFromWaitlist(ctx, tx, activity, 0); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return deleted, err
+}
+
+// 在已锁定活动行的事务中，按报名时间顺序递补候补名单中的学生，直到名额用完
+// 递补后的状态与新报名一样由活动的审核策略决定；返回被递补的报名记录 ID
+// excludeID 不为 0 时跳过该报名，用于刚被改为候补的报名，避免它立即被递补回来
+func promoteFromWaitlist(ctx context.Context, tx store.Store, activity models.Activity, excludeID int) ([]int, error) {
+	count, err := tx.Registrations().CountByStatus(ctx, activity.ID, seatHoldingStatusList)
+	if err != nil {
+		return nil, err
+	}
+
+	var promoted []int
+	// capacity 为 0 表示不限人数，此时候补名单中的所有人都可以递补
+	for activity.Capacity == 0 || count < activity.Capacity {
+		registration, err := tx.Registrations().NextWaitlistedForUpdate(ctx, activity.ID, excludeID)
+		if errors.Is(err, store.ErrNotFound) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		user, err := tx.Users().Get(ctx, registration.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		status := initialRegistrationStatus(activity.ApprovalPolicy, activity.AutoApproveColleges, user.College)
+		if err := tx.Registrations().UpdateStatus(ctx, registration.ID, status); err != nil {
+			return nil, err
+		}
+		// 记录递补历史，同时写入状态历史供学生查看
+		if err := tx.Registrations().RecordPromotion(ctx, registration.ID, activity.ID, registration.UserID); err != nil {
+			return nil, err
+		}
+		reason := "候补递补"
+		if note := approvalNote(status); note != "" {
+			reason += "，" + note
+		}
+		if err := tx.Registrations().RecordStatusChange(ctx, registration.ID, models.RegistrationWaitlisted, status, reason, 0); err != nil {
+			return nil, err
+		}
+
+		logging.FromContext(ctx).Info("候补递补", "activity_id", activity.ID, "registration_id", registration.ID, "status", status)
+		promoted = append(promoted, registration.ID)
+		count++
+	}
+	return promoted, nil
+}
@@ -0,0 +1,82 @@
+// 就绪检查：数据库连通、迁移已执行到最新等条件全部满足时服务才接收流量
+// 收到退出信号后标记为停止中，就绪检查立即失败，负载均衡不再转发新请求
+package health
+
+import (
+	"campus-activity-api/internal/logging"
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// 一项检查，返回 nil 表示通过
+type Check func(ctx context.Context) error
+
+// 一项检查的结果，就绪检查接口不需要登录，因此只返回是否通过，错误详情（可能包含数据库地址和用户名）只写入日志
+type Result struct {
+	Name string `json:"name"`
+	OK   bool   `json:"ok"`
+}
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// 就绪状态，检查项在启动时注册，之后并发只读
+type Readiness struct {
+	timeout  time.Duration
+	checks   []namedCheck
+	draining atomic.Bool
+}
+
+// 每次就绪检查的所有检查项共用 timeout 时长的超时
+func NewReadiness(timeout time.Duration) *Readiness {
+	return &Readiness{timeout: timeout}
+}
+
+// 注册一项检查，需在开始处理请求之前调用
+func (r *Readiness) Add(name string, check Check) {
+	r.checks = append(r.checks, namedCheck{name: name, check: check})
+}
+
+// 标记服务即将停止，之后的就绪检查都会失败
+func (r *Readiness) SetDraining() {
+	r.draining.Store(true)
+}
+
+func (r *Readiness) Draining() bool {
+	return r.draining.Load()
+}
+
+// 并发执行所有检查项，返回是否就绪以及每一项的结果（按注册顺序）
+func (r *Readiness) Check(ctx context.Context) (bool, []Result) {
+	if r.Draining() {
+		return false, []Result{{Name: "shutdown"}}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	results := make([]Result, len(r.checks))
+	var wg sync.WaitGroup
+	for i, c := range r.checks {
+		wg.Add(1)
+		go func(i int, c namedCheck) {
+			defer wg.Done()
+			err := c.check(ctx)
+			if err != nil {
+				logging.FromContext(ctx).Warn("就绪检查未通过", "check", c.name, "error", err)
+			}
+			results[i] = Result{Name: c.name, OK: err == nil}
+		}(i, c)
+	}
+	wg.Wait()
+
+	ready := true
+	for _, res := range results {
+		ready = ready && res.OK
+	}
+	return ready, results
+}
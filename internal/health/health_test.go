@@ -0,0 +1,34 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// 检查失败时结果中不包含错误详情，避免通过公开接口泄露数据库地址和用户名
+func TestCheckHidesErrorDetails(t *testing.T) {
+	r := NewReadiness(time.Second)
+	r.Add("database", func(ctx context.Context) error { return nil })
+	r.Add("migrations", func(ctx context.Context) error {
+		return errors.New("Access denied for user 'app'@'db.example.com'")
+	})
+
+	ready, results := r.Check(context.Background())
+	if ready {
+		t.Fatal("有检查项失败时不应就绪")
+	}
+	data, err := json.Marshal(results)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "db.example.com") {
+		t.Errorf("检查结果泄露了错误详情: %s", data)
+	}
+	if !results[0].OK || results[1].OK {
+		t.Errorf("检查结果不符: %+v", results)
+	}
+}
@@ -0,0 +1,44 @@
+// 结构化日志：基于 log/slog，默认输出 JSON
+// 每个请求的 logger 带有 request_id，由请求日志中间件放入 context，handler 和数据访问层通过 FromContext 取用
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+)
+
+// 按级别（debug、info、warn、error）和格式（json、text）创建 logger
+func New(w io.Writer, level, format string) (*slog.Logger, error) {
+	var lvl slog.Level
+	if err := lvl.UnmarshalText([]byte(level)); err != nil {
+		return nil, fmt.Errorf("无效的日志级别 %q", level)
+	}
+	opts := &slog.HandlerOptions{Level: lvl}
+
+	switch strings.ToLower(format) {
+	case "json":
+		return slog.New(slog.NewJSONHandler(w, opts)), nil
+	case "text":
+		return slog.New(slog.NewTextHandler(w, opts)), nil
+	default:
+		return nil, fmt.Errorf("无效的日志格式 %q，可选 json 或 text", format)
+	}
+}
+
+type contextKey struct{}
+
+// 返回带有 logger 的 context
+func WithContext(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, logger)
+}
+
+// 取出 context 中的 logger，没有时返回 slog.Default()
+func FromContext(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(contextKey{}).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
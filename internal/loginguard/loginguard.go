@@ -0,0 +1,206 @@
+// 登录防暴力破解：按用户名和客户端 IP 统计连续失败次数，失败后指数退避，超过次数上限临时锁定
+// 失败记录保存在可替换的 Store 中，单实例使用内存实现，多实例部署时可以换成共享存储
+package loginguard
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 一个键（用户名或 IP）的连续失败记录
+type Attempts struct {
+	Failures    int
+	LastFailure time.Time
+}
+
+// 失败记录的存储接口，实现需要保证 Reserve 和 Release 的原子性
+type Store interface {
+	// 按 policy 检查是否允许再次尝试：需要等待时返回等待时间，不修改记录；
+	// 允许时预先记录一次失败并返回 0。距上次失败超过 policy.LockoutDuration 的旧记录先清零
+	Reserve(ctx context.Context, key string, now time.Time, policy Policy) (time.Duration, error)
+	// 撤销一次 Reserve 预先记录的失败
+	Release(ctx context.Context, key string) error
+	// 清除记录
+	Delete(ctx context.Context, key string) error
+}
+
+// 限制策略
+type Policy struct {
+	MaxFailures     int           // 连续失败多少次后锁定
+	LockoutDuration time.Duration // 锁定时长，也是失败记录的保留时长
+	BaseDelay       time.Duration // 开始退避后第一次需要等待的时间，之后每次翻倍
+	MaxDelay        time.Duration // 退避等待时间的上限
+	FreeFailures    int           // 前多少次失败不需要等待，之后才开始退避
+}
+
+// 未锁定时，第 n 次失败后需要等待的时间
+func (p Policy) delay(failures int) time.Duration {
+	failures -= p.FreeFailures
+	if failures <= 0 || p.BaseDelay <= 0 {
+		return 0
+	}
+	d := p.BaseDelay
+	for i := 1; i < failures && d < p.MaxDelay; i++ {
+		d *= 2
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	return d
+}
+
+// 根据失败记录计算在 now 之后还需要等待多久才允许再次尝试
+func (p Policy) Wait(a Attempts, now time.Time) time.Duration {
+	if d := p.blockedUntil(a).Sub(now); d > 0 {
+		return d
+	}
+	return 0
+}
+
+// 根据失败记录计算在什么时间之前不允许再次尝试
+func (p Policy) blockedUntil(a Attempts) time.Time {
+	if a.Failures == 0 {
+		return time.Time{}
+	}
+	if p.MaxFailures > 0 && a.Failures >= p.MaxFailures {
+		return a.LastFailure.Add(p.LockoutDuration)
+	}
+	return a.LastFailure.Add(p.delay(a.Failures))
+}
+
+// 登录限制器，用户名和 IP 各自使用一套策略
+// 同一个 IP 可能是整栋宿舍楼的出口，IP 策略的上限应明显高于用户名策略
+type Guard struct {
+	store      Store
+	userPolicy Policy
+	ipPolicy   Policy
+	now        func() time.Time
+}
+
+func New(store Store, userPolicy, ipPolicy Policy) *Guard {
+	return &Guard{store: store, userPolicy: userPolicy, ipPolicy: ipPolicy, now: time.Now}
+}
+
+// 用户名不区分大小写，避免通过改变大小写绕过限制
+func userKey(username string) string {
+	return "user:" + strings.ToLower(strings.TrimSpace(username))
+}
+
+func ipKey(ip string) string {
+	return "ip:" + ip
+}
+
+// 登记一次登录尝试，必须在校验密码之前调用；需要等待时返回等待时间，本次尝试不计入
+// 允许时先把本次尝试按失败记录下来，这样并发的一批尝试中只有第一个能通过，
+// 其余的立即进入退避；密码正确时调用 Success，尝试无法完成时调用 Abort
+// 不论用户名是否存在都按同样的规则处理，因此锁定状态不会暴露账号是否存在
+func (g *Guard) Attempt(ctx context.Context, username, ip string) (time.Duration, error) {
+	now := g.now()
+	wait, err := g.store.Reserve(ctx, userKey(username), now, g.userPolicy)
+	if err != nil || wait > 0 {
+		return wait, err
+	}
+	wait, err = g.store.Reserve(ctx, ipKey(ip), now, g.ipPolicy)
+	if err != nil || wait > 0 {
+		// IP 被限制时本次尝试不计入用户名的失败次数
+		if releaseErr := g.store.Release(ctx, userKey(username)); err == nil {
+			err = releaseErr
+		}
+		return wait, err
+	}
+	return 0, nil
+}
+
+// 登录成功：清除该用户名的失败记录，撤销本次尝试对 IP 的计数
+// IP 之前的失败记录保留，避免攻击者用自己的账号登录成功来重置 IP 计数
+func (g *Guard) Success(ctx context.Context, username, ip string) error {
+	if err := g.store.Delete(ctx, userKey(username)); err != nil {
+		return err
+	}
+	return g.store.Release(ctx, ipKey(ip))
+}
+
+// 撤销本次尝试的计数，用于数据库故障等无法判断密码是否正确的情况，避免故障期间把所有人锁定
+func (g *Guard) Abort(ctx context.Context, username, ip string) error {
+	if err := g.store.Release(ctx, userKey(username)); err != nil {
+		return err
+	}
+	return g.store.Release(ctx, ipKey(ip))
+}
+
+// 管理员解除某个用户名的锁定
+func (g *Guard) Unlock(ctx context.Context, username string) error {
+	return g.store.Delete(ctx, userKey(username))
+}
+
+// 进程内的失败记录存储，只适用于单实例部署
+type MemoryStore struct {
+	mu       sync.Mutex
+	entries  map[string]memoryEntry
+	prunedAt time.Time
+}
+
+// 清理过期记录的最短间隔
+const memoryPruneInterval = time.Minute
+
+type memoryEntry struct {
+	attempts  Attempts
+	expiresAt time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: map[string]memoryEntry{}}
+}
+
+func (s *MemoryStore) Reserve(ctx context.Context, key string, now time.Time, policy Policy) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// 顺带清理过期的记录，防止大量随机用户名占满内存
+	if now.Sub(s.prunedAt) > memoryPruneInterval {
+		for k, e := range s.entries {
+			if now.After(e.expiresAt) {
+				delete(s.entries, k)
+			}
+		}
+		s.prunedAt = now
+	}
+
+	e := s.entries[key]
+	if now.After(e.expiresAt) {
+		e.attempts = Attempts{}
+	}
+	if wait := policy.Wait(e.attempts, now); wait > 0 {
+		return wait, nil
+	}
+	e.attempts.Failures++
+	e.attempts.LastFailure = now
+	e.expiresAt = now.Add(policy.LockoutDuration)
+	s.entries[key] = e
+	return 0, nil
+}
+
+func (s *MemoryStore) Release(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entries[key]
+	if !ok || e.attempts.Failures == 0 {
+		return nil
+	}
+	e.attempts.Failures--
+	if e.attempts.Failures == 0 {
+		delete(s.entries, key)
+		return nil
+	}
+	s.entries[key] = e
+	return nil
+}
+
+func (s *MemoryStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, key)
+	return nil
+}
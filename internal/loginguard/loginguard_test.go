@@ -0,0 +1,131 @@
+package loginguard
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newTestGuard() *Guard {
+	return New(NewMemoryStore(),
+		Policy{MaxFailures: 5, LockoutDuration: 15 * time.Minute, BaseDelay: time.Second, MaxDelay: 30 * time.Second},
+		Policy{MaxFailures: 3, LockoutDuration: 15 * time.Minute},
+	)
+}
+
+// 并发的一批尝试在任何一个失败之前同时到达，只有第一个可以继续校验密码
+func TestAttemptConcurrentBurst(t *testing.T) {
+	g := newTestGuard()
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	allowed := 0
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wait, err := g.Attempt(ctx, "20230001", "10.0.0.1")
+			if err != nil {
+				t.Error(err)
+			}
+			if wait == 0 {
+				mu.Lock()
+				allowed++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if allowed != 1 {
+		t.Errorf("并发尝试中有 %d 个被放行, 期望 1 个", allowed)
+	}
+}
+
+// 登录成功清除用户名记录并撤销本次尝试对 IP 的计数，同一出口下的正常登录不会累积到 IP 上限
+func TestSuccessReleasesIPAttempt(t *testing.T) {
+	g := newTestGuard()
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		username := "student" + string(rune('a'+i))
+		if wait, err := g.Attempt(ctx, username, "10.0.0.1"); err != nil || wait > 0 {
+			t.Fatalf("第 %d 次登录被限制: %v, %v", i+1, wait, err)
+		}
+		if err := g.Success(ctx, username, "10.0.0.1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// 同一 IP 换着用户名连续失败达到上限后，任何用户名都被锁定，锁定时长过后恢复
+func TestIPLockout(t *testing.T) {
+	g := newTestGuard()
+	ctx := context.Background()
+	now := time.Date(2025, 11, 1, 9, 0, 0, 0, time.UTC)
+	g.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		if wait, err := g.Attempt(ctx, "student"+string(rune('a'+i)), "10.0.0.1"); err != nil || wait > 0 {
+			t.Fatalf("第 %d 次尝试被限制: %v, %v", i+1, wait, err)
+		}
+	}
+
+	wait, err := g.Attempt(ctx, "studentz", "10.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wait != 15*time.Minute {
+		t.Errorf("IP 达到上限后等待 %v, 期望锁定 15 分钟", wait)
+	}
+	// 被 IP 锁定的尝试不计入用户名的失败次数
+	if wait, _ := g.Attempt(ctx, "studentz", "10.0.0.2"); wait > 0 {
+		t.Errorf("换一个 IP 后仍需等待 %v", wait)
+	}
+
+	now = now.Add(15 * time.Minute)
+	if wait, _ := g.Attempt(ctx, "studenty", "10.0.0.1"); wait > 0 {
+		t.Errorf("锁定结束后仍需等待 %v", wait)
+	}
+}
+
+// 未配置可信代理时 IP 策略超过次数上限后只退避，等待时间不超过上限，不会锁定
+func TestIPBackoffWithoutLockout(t *testing.T) {
+	g := New(NewMemoryStore(),
+		Policy{MaxFailures: 5, LockoutDuration: 15 * time.Minute, BaseDelay: time.Second, MaxDelay: 30 * time.Second},
+		Policy{LockoutDuration: 15 * time.Minute, FreeFailures: 3, BaseDelay: time.Second, MaxDelay: 30 * time.Second},
+	)
+	ctx := context.Background()
+	now := time.Date(2025, 11, 1, 9, 0, 0, 0, time.UTC)
+	g.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		if wait, err := g.Attempt(ctx, "user"+strconv.Itoa(i), "10.0.0.1"); err != nil || wait > 0 {
+			t.Fatalf("第 %d 次尝试被限制: %v, %v", i+1, wait, err)
+		}
+	}
+	backedOff := false
+	for i := 3; i < 20; i++ {
+		username := "user" + strconv.Itoa(i)
+		wait, err := g.Attempt(ctx, username, "10.0.0.1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if wait > 30*time.Second {
+			t.Fatalf("第 %d 次尝试需要等待 %v, 超过退避上限", i+1, wait)
+		}
+		if wait == 0 {
+			continue
+		}
+		backedOff = true
+		now = now.Add(wait)
+		if wait, _ := g.Attempt(ctx, username, "10.0.0.1"); wait > 0 {
+			t.Fatalf("等待结束后第 %d 次尝试仍需等待 %v", i+1, wait)
+		}
+	}
+	if !backedOff {
+		t.Error("超过次数上限后没有退避")
+	}
+}
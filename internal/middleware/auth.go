@@ -1,60 +1,71 @@
 package middleware
 
 import (
-	"campus-activity-api/internal/config"
+	"campus-activity-api/internal/auth"
 	"net/http"
 	"strings"
 
-	"github.com/dgrijalva/jwt-go"
 	"github.com/gin-gonic/gin"
 )
 
-func AuthMiddleware() gin.HandlerFunc {
+// 认证中间件：校验 Bearer token，并检查其 jti 是否已被吊销
+// revocations 为 nil 时不检查吊销
+func AuthMiddleware(tokens *auth.Manager, revocations *auth.RevocationList) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// 获取 Authorization 头
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "请求未包含token"})
-			c.Abort()// 中断处理链
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "请求未包含token", "code": "UNAUTHORIZED"})
+			c.Abort() // 中断处理链
 			return
 		}
 
-		// 检查 Token 格式
-		parts := strings.Split(authHeader, " ")
-		if len(parts) != 2 || parts[0] != "Bearer" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Token格式不正确"})
+		claims, msg := parseBearerToken(tokens, authHeader)
+		if claims == nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": msg, "code": "UNAUTHORIZED"})
 			c.Abort()
 			return
 		}
 
-		// 解析 JWT
-		tokenString := parts[1]
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			// 安全检验
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, jwt.NewValidationError("unexpected signing method", jwt.ValidationErrorSignatureInvalid)
-			}
-			return []byte(config.Cfg.JWT.Secret), nil
-		})
-
-		// 验证 token 是否有效
-		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Token无效"})
-			c.Abort()
-			return
-		}
-
-		// 提取 claims（负载信息）
-		if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-			c.Set("userID", claims["id"])
-			c.Set("userRole", claims["role"])
-		} else {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Token无效"})
+		// 已退出登录或被管理员强制下线的 token
+		if revocations != nil && revocations.IsRevoked(claims.ID) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "登录已失效，请重新登录", "code": "TOKEN_REVOKED"})
 			c.Abort()
 			return
 		}
+		setCurrentUser(c, claims)
 
 		// 放行请求
 		c.Next()
 	}
 }
+
+// 可选认证：携带有效 token 时写入用户信息，未携带或 token 无效时按匿名用户放行
+// 用于公开接口中只对登录用户生效的功能，如活动列表的 eligibleFor=me 筛选
+func OptionalAuthMiddleware(tokens *auth.Manager, revocations *auth.RevocationList) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if authHeader := c.GetHeader("Authorization"); authHeader != "" {
+			claims, _ := parseBearerToken(tokens, authHeader)
+			if claims != nil && (revocations == nil || !revocations.IsRevoked(claims.ID)) {
+				setCurrentUser(c, claims)
+			}
+		}
+		c.Next()
+	}
+}
+
+// 解析 "Bearer <token>" 形式的 Authorization 头，失败时返回 nil 和错误提示
+func parseBearerToken(tokens *auth.Manager, authHeader string) (*auth.Claims, string) {
+	// 检查 Token 格式
+	parts := strings.Split(authHeader, " ")
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return nil, "Token格式不正确"
+	}
+
+	// 校验签名、有效期、签发方和受众
+	claims, err := tokens.Parse(parts[1])
+	if err != nil {
+		return nil, "Token无效"
+	}
+	return claims, ""
+}
@@ -0,0 +1,101 @@
+package middleware
+
+import (
+	"campus-activity-api/internal/auth"
+	"campus-activity-api/internal/logging"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 请求 ID 的请求头和响应头
+const RequestIDHeader = "X-Request-ID"
+
+// 客户端传入的请求 ID 最长长度，超过或包含非可见字符时重新生成
+const maxRequestIDLength = 128
+
+// 请求日志中间件，需要作为第一个中间件注册
+// 沿用客户端或网关传入的 X-Request-ID，没有时生成一个，并写回响应头；
+// 带有 request_id 的 logger 放入请求的 context，请求结束后记录方法、路由、状态码、耗时和当前用户
+func RequestLogger(logger *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		requestID := c.GetHeader(RequestIDHeader)
+		if !validRequestID(requestID) {
+			requestID = newRequestID()
+		}
+		c.Header(RequestIDHeader, requestID)
+
+		reqLogger := logger.With("request_id", requestID)
+		c.Request = c.Request.WithContext(logging.WithContext(c.Request.Context(), reqLogger))
+
+		c.Next()
+
+		status := c.Writer.Status()
+		attrs := []slog.Attr{
+			slog.String("method", c.Request.Method),
+			slog.String("route", c.FullPath()), // 未匹配到路由时为空
+			slog.String("path", c.Request.URL.Path),
+			slog.Int("status", status),
+			slog.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+			slog.String("client_ip", c.ClientIP()),
+			slog.Int("bytes", max(c.Writer.Size(), 0)), // 未写出响应体时 Size 为 -1
+		}
+		if user, ok := auth.CurrentUser(c); ok {
+			attrs = append(attrs, slog.Int("user_id", user.UserID), slog.String("role", user.Role))
+		}
+		if len(c.Errors) > 0 {
+			attrs = append(attrs, slog.String("errors", c.Errors.String()))
+		}
+
+		level := slog.LevelInfo
+		switch {
+		case status >= http.StatusInternalServerError:
+			level = slog.LevelError
+		case status >= http.StatusBadRequest:
+			level = slog.LevelWarn
+		}
+		reqLogger.LogAttrs(c.Request.Context(), level, "request", attrs...)
+	}
+}
+
+// 捕获 handler 中的 panic，记录到请求日志并返回 500，需要注册在 RequestLogger 之后
+func Recovery() gin.HandlerFunc {
+	return gin.CustomRecoveryWithWriter(nil, func(c *gin.Context, err any) {
+		logging.FromContext(c.Request.Context()).Error("处理请求时发生 panic",
+			"panic", err, "stack", string(debug.Stack()))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "服务器内部错误", "code": "INTERNAL_ERROR"})
+	})
+}
+
+// 写入当前用户，并把用户 ID 和角色加入请求的 logger，之后的日志都能关联到用户
+func setCurrentUser(c *gin.Context, claims *auth.Claims) {
+	auth.SetClaims(c, claims)
+	ctx := c.Request.Context()
+	logger := logging.FromContext(ctx).With("user_id", claims.UserID, "role", claims.Role)
+	c.Request = c.Request.WithContext(logging.WithContext(ctx, logger))
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// 16 字节随机数的十六进制表示
+func newRequestID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"campus-activity-api/internal/auth"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 角色鉴权中间件，必须挂在 AuthMiddleware 之后使用
+// 只有 token 中的角色属于 roles 之一时才放行，否则返回 403
+func RequireRoles(roles ...string) gin.HandlerFunc {
+	// 预先构建允许的角色集合，避免每次请求都遍历
+	allowed := make(map[string]struct{}, len(roles))
+	for _, role := range roles {
+		allowed[role] = struct{}{}
+	}
+
+	return func(c *gin.Context) {
+		// 从 AuthMiddleware 写入的上下文中读取角色
+		user, exists := auth.CurrentUser(c)
+		if !exists {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "用户未登录", "code": "UNAUTHORIZED"})
+			c.Abort()
+			return
+		}
+
+		if _, ok := allowed[user.Role]; !ok {
+			c.JSON(http.StatusForbidden, gin.H{"error": "权限不足", "code": "FORBIDDEN"})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,345 @@
+// 数据库迁移：编号递增的 up/down SQL 文件通过 embed 打包进二进制，已执行的版本记录在 schema_migrations 表中
+// 迁移文件命名为 <6 位版本号>_<名称>.up.sql 和 <6 位版本号>_<名称>.down.sql，位于 migrations 目录
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+//go:embed seed/seed.sql
+var seedSQL string
+
+// 源码中迁移文件所在的目录（相对于仓库根目录），migrate create 默认在这里生成新文件
+const SourceDir = "internal/migrate/migrations"
+
+// 基线迁移的版本号，与引入迁移工具之前 campus_activity.sql 中的表结构完全一致
+const BaselineVersion = 1
+
+// 记录已执行迁移的表
+const createVersionTable = `CREATE TABLE IF NOT EXISTS schema_migrations (
+	version BIGINT NOT NULL PRIMARY KEY,
+	name VARCHAR(255) NOT NULL,
+	applied_at DATETIME NOT NULL
+)`
+
+// 示例数据只能导入到空库中，以 users 表是否有数据为准
+var ErrNotEmpty = errors.New("database already contains data")
+
+var fileNamePattern = regexp.MustCompile(`^(\d{6})_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// 一个版本的迁移
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// 迁移的执行状态
+type Status struct {
+	Migration
+	AppliedAt *time.Time // 未执行时为 nil
+}
+
+// 迁移执行器，所有语句在同一个连接上执行，保证 SET 等会话级语句对后续语句生效
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// 使用打包进二进制的迁移文件创建执行器
+func New(db *sql.DB) (*Migrator, error) {
+	migrations, err := Load(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// 读取 dir 目录下的迁移文件，按版本号升序返回；每个版本必须同时有 up 和 down 文件
+func Load(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int]*Migration{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		m := fileNamePattern.FindStringSubmatch(entry.Name())
+		if m == nil {
+			return nil, fmt.Errorf("无效的迁移文件名: %s", entry.Name())
+		}
+		version, _ := strconv.Atoi(m[1])
+		content, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: m[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != m[2] {
+			return nil, fmt.Errorf("版本 %06d 的迁移文件名称不一致: %s 和 %s", version, migration.Name, m[2])
+		}
+		if m[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("版本 %06d_%s 缺少 up 或 down 文件", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// 所有迁移及其执行状态，按版本号升序
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	applied, err := appliedVersions(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]Status, len(m.migrations))
+	for i, migration := range m.migrations {
+		result[i] = Status{Migration: migration}
+		if t, ok := applied[migration.Version]; ok {
+			result[i].AppliedAt = &t
+		}
+	}
+	return result, nil
+}
+
+// 尚未执行的迁移，按版本号升序；只读取 schema_migrations，不会建表，用于就绪检查
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	applied, err := readApplied(ctx, m.db)
+	if err != nil {
+		return nil, err
+	}
+	pending := []Migration{}
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; !ok {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+// 按版本号顺序执行所有未执行的迁移，返回本次执行的迁移
+// MySQL 的 DDL 会隐式提交，迁移中途失败时已执行的语句无法回滚，需要手动修复后重试
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	applied, err := appliedVersions(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	done := []Migration{}
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; ok {
+			continue
+		}
+		if err := execScript(ctx, conn, migration.Up); err != nil {
+			return done, fmt.Errorf("执行迁移 %06d_%s 失败: %w", migration.Version, migration.Name, err)
+		}
+		if _, err := conn.ExecContext(ctx,
+			"INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)",
+			migration.Version, migration.Name, time.Now()); err != nil {
+			return done, err
+		}
+		done = append(done, migration)
+	}
+	return done, nil
+}
+
+// 按版本号倒序回滚最近执行的 steps 个迁移，返回本次回滚的迁移
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	applied, err := appliedVersions(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	done := []Migration{}
+	for i := len(m.migrations) - 1; i >= 0 && len(done) < steps; i-- {
+		migration := m.migrations[i]
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+		if err := execScript(ctx, conn, migration.Down); err != nil {
+			return done, fmt.Errorf("回滚迁移 %06d_%s 失败: %w", migration.Version, migration.Name, err)
+		}
+		if _, err := conn.ExecContext(ctx, "DELETE FROM schema_migrations WHERE version = ?", migration.Version); err != nil {
+			return done, err
+		}
+		done = append(done, migration)
+	}
+	return done, nil
+}
+
+// 把基线迁移标记为已执行而不实际执行，用于引入迁移工具之前由 campus_activity.sql 建好的数据库；之后的迁移仍需执行 up
+func (m *Migrator) Baseline(ctx context.Context) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	applied, err := appliedVersions(ctx, conn)
+	if err != nil {
+		return err
+	}
+	if _, ok := applied[BaselineVersion]; ok {
+		return nil
+	}
+	for _, migration := range m.migrations {
+		if migration.Version == BaselineVersion {
+			_, err := conn.ExecContext(ctx,
+				"INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)",
+				migration.Version, migration.Name, time.Now())
+			return err
+		}
+	}
+	return fmt.Errorf("找不到基线迁移 %06d", BaselineVersion)
+}
+
+// 导入开发和演示用的示例数据，库中已有用户时返回 ErrNotEmpty
+func (m *Migrator) Seed(ctx context.Context) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var count int
+	if err := conn.QueryRowContext(ctx, "SELECT COUNT(*) FROM users").Scan(&count); err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrNotEmpty
+	}
+	return execScript(ctx, conn, seedSQL)
+}
+
+// 在 dir 目录下生成下一个版本号的空迁移文件，返回生成的文件路径
+func Create(dir, name string) ([]string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.Join(strings.FieldsFunc(name, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9')
+	}), "_")
+	if name == "" {
+		return nil, errors.New("迁移名称只能包含字母、数字和下划线")
+	}
+
+	existing, err := Load(os.DirFS(dir), ".")
+	if err != nil {
+		return nil, err
+	}
+	version := 1
+	if len(existing) > 0 {
+		version = existing[len(existing)-1].Version + 1
+	}
+
+	paths := []string{}
+	for _, direction := range []string{"up", "down"} {
+		p := filepath.Join(dir, fmt.Sprintf("%06d_%s.%s.sql", version, name, direction))
+		content := fmt.Sprintf("-- %06d_%s (%s)\n", version, name, direction)
+		// O_EXCL 防止覆盖已有文件
+		f, err := os.OpenFile(p, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if err != nil {
+			return paths, err
+		}
+		_, err = f.WriteString(content)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return paths, err
+		}
+		paths = append(paths, p)
+	}
+	return paths, nil
+}
+
+// 确保 schema_migrations 表存在，并读取已执行的版本
+func appliedVersions(ctx context.Context, conn *sql.Conn) (map[int]time.Time, error) {
+	if _, err := conn.ExecContext(ctx, createVersionTable); err != nil {
+		return nil, err
+	}
+	return readApplied(ctx, conn)
+}
+
+// *sql.DB 和 *sql.Conn 共有的查询方法
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// 读取已执行的版本，schema_migrations 表不存在时返回错误
+func readApplied(ctx context.Context, q queryer) (map[int]time.Time, error) {
+	rows, err := q.QueryContext(ctx, "SELECT version, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := map[int]time.Time{}
+	for rows.Next() {
+		var version int
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = appliedAt
+	}
+	return applied, rows.Err()
+}
+
+// 逐条执行脚本中的语句；不依赖驱动的 multiStatements 选项
+func execScript(ctx context.Context, conn *sql.Conn, script string) error {
+	for _, stmt := range splitStatements(script) {
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,398 @@
+package migrate
+
+import (
+	"campus-activity-api/internal/store/sqlite"
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	_ "github.com/go-sql-driver/mysql"
+)
+
+// 测试用的表结构模型：表名 -> 列（按顺序）和索引、约束等其余定义
+// 只解析迁移文件中用到的语句，遇到不认识的语句直接报错，新增迁移用到新语法时需要在这里补上
+type schema map[string]*table
+
+type table struct {
+	Columns []column
+	Keys    []string // 主键、索引和外键，规范化后的原文
+	Options string   // ENGINE、COMMENT 等表选项，去掉 AUTO_INCREMENT
+}
+
+type column struct {
+	Name       string
+	Definition string
+}
+
+var (
+	spacePattern     = regexp.MustCompile(`\s+`)
+	createPattern    = regexp.MustCompile("(?s)^CREATE TABLE `(\\w+)`\\s*\\((.*)\\)(.*)$")
+	alterPattern     = regexp.MustCompile("(?s)^ALTER TABLE `(\\w+)`(.*)$")
+	dropPattern      = regexp.MustCompile("^DROP TABLE IF EXISTS `(\\w+)`$")
+	columnPattern    = regexp.MustCompile("^`(\\w+)` (.*)$")
+	afterPattern     = regexp.MustCompile(" AFTER `(\\w+)`$")
+	autoIncrementOpt = regexp.MustCompile(` AUTO_INCREMENT = \d+`)
+)
+
+func normalize(s string) string {
+	return strings.TrimSpace(spacePattern.ReplaceAllString(s, " "))
+}
+
+// 迁移文件和 campus_activity.sql 都是每行一个列定义或子句，按行拆分即可
+func clauses(body string) []string {
+	var out []string
+	for _, line := range strings.Split(body, "\n") {
+		if line = normalize(strings.TrimSuffix(strings.TrimSpace(line), ",")); line != "" {
+			out = append(out, line)
+		}
+	}
+	return out
+}
+
+func (s schema) apply(script string) error {
+	for _, stmt := range splitStatements(script) {
+		stmt = strings.TrimSpace(stmt)
+		switch {
+		case strings.HasPrefix(stmt, "SET "):
+		case dropPattern.MatchString(stmt):
+			delete(s, dropPattern.FindStringSubmatch(stmt)[1])
+		case createPattern.MatchString(stmt):
+			m := createPattern.FindStringSubmatch(stmt)
+			if _, ok := s[m[1]]; ok {
+				return fmt.Errorf("表 %s 已存在", m[1])
+			}
+			t := &table{Options: normalize(autoIncrementOpt.ReplaceAllString(m[3], ""))}
+			for _, clause := range clauses(m[2]) {
+				if c := columnPattern.FindStringSubmatch(clause); c != nil {
+					t.Columns = append(t.Columns, column{Name: c[1], Definition: c[2]})
+				} else {
+					t.Keys = append(t.Keys, clause)
+				}
+			}
+			s[m[1]] = t
+		case alterPattern.MatchString(stmt):
+			m := alterPattern.FindStringSubmatch(stmt)
+			t, ok := s[m[1]]
+			if !ok {
+				return fmt.Errorf("表 %s 不存在", m[1])
+			}
+			for _, clause := range clauses(m[2]) {
+				if err := t.alter(clause); err != nil {
+					return fmt.Errorf("%s: %w", m[1], err)
+				}
+			}
+		default:
+			return fmt.Errorf("测试无法解析的语句: %.60s", stmt)
+		}
+	}
+	return nil
+}
+
+func (t *table) index(name string) int {
+	for i, c := range t.Columns {
+		if c.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (t *table) alter(clause string) error {
+	verb, rest, _ := strings.Cut(clause, " COLUMN ")
+	c := columnPattern.FindStringSubmatch(rest)
+	if c == nil && verb != "DROP" {
+		return fmt.Errorf("无法解析 %q", clause)
+	}
+	switch verb {
+	case "ADD":
+		if t.index(c[1]) >= 0 {
+			return fmt.Errorf("列 %s 已存在", c[1])
+		}
+		def, pos := c[2], len(t.Columns)
+		if a := afterPattern.FindStringSubmatch(def); a != nil {
+			if pos = t.index(a[1]); pos < 0 {
+				return fmt.Errorf("列 %s 不存在", a[1])
+			}
+			pos++
+			def = strings.TrimSuffix(def, a[0])
+		}
+		t.Columns = append(t.Columns[:pos], append([]column{{Name: c[1], Definition: def}}, t.Columns[pos:]...)...)
+	case "MODIFY":
+		i := t.index(c[1])
+		if i < 0 {
+			return fmt.Errorf("列 %s 不存在", c[1])
+		}
+		t.Columns[i].Definition = c[2]
+	case "DROP":
+		name := strings.Trim(rest, "`")
+		i := t.index(name)
+		if i < 0 {
+			return fmt.Errorf("列 %s 不存在", name)
+		}
+		t.Columns = append(t.Columns[:i], t.Columns[i+1:]...)
+	default:
+		return fmt.Errorf("无法解析 %q", clause)
+	}
+	return nil
+}
+
+func (s schema) clone() schema {
+	out := schema{}
+	for name, t := range s {
+		c := *t
+		c.Columns = append([]column(nil), t.Columns...)
+		c.Keys = append([]string(nil), t.Keys...)
+		out[name] = &c
+	}
+	return out
+}
+
+func loadMigrations(t *testing.T) []Migration {
+	t.Helper()
+	migrations, err := Load(migrationFiles, "migrations")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(migrations) == 0 || migrations[0].Version != BaselineVersion {
+		t.Fatalf("第一个迁移应为基线 %06d", BaselineVersion)
+	}
+	return migrations
+}
+
+func originalSchema(t *testing.T) schema {
+	t.Helper()
+	script, err := os.ReadFile("testdata/campus_activity.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := schema{}
+	if err := s.apply(string(script)); err != nil {
+		t.Fatalf("campus_activity.sql: %v", err)
+	}
+	return s
+}
+
+// 基线迁移必须与 campus_activity.sql 建出的表完全一致，否则旧库执行 baseline 后表结构与迁移记录不符
+func TestBaselineMatchesCampusActivitySQL(t *testing.T) {
+	migrations := loadMigrations(t)
+	baseline := schema{}
+	if err := baseline.apply(migrations[0].Up); err != nil {
+		t.Fatalf("基线迁移: %v", err)
+	}
+	if want := originalSchema(t); !reflect.DeepEqual(baseline, want) {
+		t.Errorf("基线迁移与 campus_activity.sql 不一致\n got: %+v\nwant: %+v", dump(baseline), dump(want))
+	}
+}
+
+// 旧库执行 baseline 再执行 up 后的表结构与新库执行 up 的结果相同，并且每个迁移的 down 都能撤销其 up
+func TestBaselineThenUp(t *testing.T) {
+	migrations := loadMigrations(t)
+
+	fresh := schema{}
+	for _, m := range migrations {
+		if err := fresh.apply(m.Up); err != nil {
+			t.Fatalf("%06d_%s up: %v", m.Version, m.Name, err)
+		}
+	}
+
+	upgraded := originalSchema(t)
+	for _, m := range migrations[1:] {
+		before := upgraded.clone()
+		if err := upgraded.apply(m.Up); err != nil {
+			t.Fatalf("%06d_%s up: %v", m.Version, m.Name, err)
+		}
+		reverted := upgraded.clone()
+		if err := reverted.apply(m.Down); err != nil {
+			t.Fatalf("%06d_%s down: %v", m.Version, m.Name, err)
+		}
+		if !reflect.DeepEqual(reverted, before) {
+			t.Errorf("%06d_%s 的 down 没有完全撤销 up\n got: %+v\nwant: %+v", m.Version, m.Name, dump(reverted), dump(before))
+		}
+	}
+	if !reflect.DeepEqual(upgraded, fresh) {
+		t.Errorf("baseline 后 up 的结果与新库不一致\n got: %+v\nwant: %+v", dump(upgraded), dump(fresh))
+	}
+
+	if err := upgraded.apply(migrations[0].Down); err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range migrations[1:] {
+		if err := fresh.apply(m.Down); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := fresh.apply(migrations[0].Down); err != nil {
+		t.Fatal(err)
+	}
+	if len(fresh) != 0 {
+		t.Errorf("全部回滚后仍有表: %v", dump(fresh))
+	}
+}
+
+// 在真实的 MySQL 上验证：用 campus_activity.sql 建库，执行 baseline 和 up 后没有待执行的迁移，新增的列可以查询
+// 需要一个空的测试库，通过 CAMPUS_TEST_MYSQL_DSN 指定，例如 root:secret@tcp(127.0.0.1:3306)/campus_test?parseTime=true
+func TestMySQLBaselineThenUp(t *testing.T) {
+	dsn := os.Getenv("CAMPUS_TEST_MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("未设置 CAMPUS_TEST_MYSQL_DSN")
+	}
+	ctx := context.Background()
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	script, err := os.ReadFile("testdata/campus_activity.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = execScript(ctx, conn, string(script))
+	conn.Close()
+	if err != nil {
+		t.Fatalf("执行 campus_activity.sql: %v", err)
+	}
+
+	m, err := New(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if _, err := m.Down(ctx, len(m.migrations)); err != nil {
+			t.Errorf("回滚: %v", err)
+		}
+		db.ExecContext(ctx, "DROP TABLE IF EXISTS schema_migrations")
+	})
+
+	if err := m.Baseline(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatalf("baseline 后执行 up: %v", err)
+	}
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 0 {
+		t.Errorf("仍有 %d 个待执行的迁移", len(pending))
+	}
+
+	for _, query := range []string{
+		"SELECT approval_policy, auto_approve_colleges, registration_opens_at, registration_closes_at, cancellation_deadline FROM activities LIMIT 1",
+		"SELECT checked_in_at, checked_in_by_id FROM registrations LIMIT 1",
+		"SELECT id FROM registration_status_history LIMIT 1",
+		"SELECT id FROM waitlist_promotions LIMIT 1",
+		"SELECT id FROM activity_eligibility_rules LIMIT 1",
+		"SELECT id FROM refresh_tokens LIMIT 1",
+		"SELECT jti FROM revoked_tokens LIMIT 1",
+		"SELECT id FROM password_reset_tokens LIMIT 1",
+	} {
+		if _, err := db.ExecContext(ctx, query); err != nil {
+			t.Errorf("%s: %v", query, err)
+		}
+	}
+}
+
+// 测试和本地开发使用的 SQLite 表结构是手写的，与执行全部 MySQL 迁移后的表结构比对表、列（按顺序）、类型和是否可空
+func TestSQLiteSchemaMatchesMigrations(t *testing.T) {
+	mysql := schema{}
+	for _, m := range loadMigrations(t) {
+		if err := mysql.apply(m.Up); err != nil {
+			t.Fatalf("%06d_%s up: %v", m.Version, m.Name, err)
+		}
+	}
+	want := map[string][]string{}
+	for name, table := range mysql {
+		for _, c := range table.Columns {
+			want[name] = append(want[name], c.Name+" "+sqliteType(c.Definition)+" "+nullability(strings.Contains(c.Definition, "NOT NULL")))
+		}
+	}
+
+	ctx := context.Background()
+	db, err := sqlite.Open(ctx, ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	got := map[string][]string{}
+	rows, err := db.QueryContext(ctx, "SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tables []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			t.Fatal(err)
+		}
+		tables = append(tables, name)
+	}
+	rows.Close()
+	for _, name := range tables {
+		rows, err := db.QueryContext(ctx, "SELECT name, type, \"notnull\", pk FROM pragma_table_info(?) ORDER BY cid", name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for rows.Next() {
+			var column, typ string
+			var notNull, pk int
+			if err := rows.Scan(&column, &typ, &notNull, &pk); err != nil {
+				t.Fatal(err)
+			}
+			// 主键列隐含非空
+			got[name] = append(got[name], column+" "+typ+" "+nullability(notNull == 1 || pk > 0))
+		}
+		rows.Close()
+	}
+
+	for name, columns := range want {
+		if !reflect.DeepEqual(got[name], columns) {
+			t.Errorf("表 %s 不一致\nSQLite: %v\nMySQL:  %v", name, got[name], columns)
+		}
+	}
+	for name := range got {
+		if _, ok := want[name]; !ok {
+			t.Errorf("SQLite 中多出了表 %s", name)
+		}
+	}
+}
+
+// MySQL 列类型在 SQLite 表结构中对应的类型
+func sqliteType(definition string) string {
+	typ, _, _ := strings.Cut(definition, " ")
+	typ, _, _ = strings.Cut(typ, "(")
+	switch typ {
+	case "int":
+		return "INTEGER"
+	case "varchar", "char", "text":
+		return "TEXT"
+	default:
+		return strings.ToUpper(typ)
+	}
+}
+
+func nullability(notNull bool) string {
+	if notNull {
+		return "NOT NULL"
+	}
+	return "NULL"
+}
+
+// 便于在失败信息中查看的表结构
+func dump(s schema) map[string]table {
+	out := map[string]table{}
+	for name, t := range s {
+		out[name] = *t
+	}
+	return out
+}
@@ -0,0 +1,5 @@
+-- 删除基线中的全部表，按外键依赖的逆序删除
+
+DROP TABLE IF EXISTS `registrations`;
+DROP TABLE IF EXISTS `activities`;
+DROP TABLE IF EXISTS `users`;
@@ -0,0 +1,17 @@
+-- 候补名单：记录每一次递补，报名状态新增 waitlisted
+
+CREATE TABLE `waitlist_promotions` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `registration_id` int NOT NULL COMMENT '被递补的报名记录ID',
+  `activity_id` int NOT NULL COMMENT '活动ID',
+  `user_id` int NOT NULL COMMENT '被递补的用户ID',
+  `promoted_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP COMMENT '递补时间',
+  PRIMARY KEY (`id`) USING BTREE,
+  INDEX `registration_id`(`registration_id` ASC) USING BTREE,
+  INDEX `activity_id`(`activity_id` ASC) USING BTREE,
+  CONSTRAINT `waitlist_promotions_ibfk_1` FOREIGN KEY (`registration_id`) REFERENCES `registrations` (`id`) ON DELETE CASCADE ON UPDATE RESTRICT,
+  CONSTRAINT `waitlist_promotions_ibfk_2` FOREIGN KEY (`activity_id`) REFERENCES `activities` (`id`) ON DELETE CASCADE ON UPDATE RESTRICT
+) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_unicode_ci COMMENT = '候补递补记录表' ROW_FORMAT = DYNAMIC;
+
+ALTER TABLE `registrations`
+  MODIFY COLUMN `status` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'pending' COMMENT '报名状态 (pending, approved, waitlisted)';
@@ -0,0 +1,2 @@
+ALTER TABLE `users`
+  MODIFY COLUMN `role` varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'student' COMMENT '角色 (student, admin)';
@@ -0,0 +1,3 @@
+ALTER TABLE `registrations`
+  DROP COLUMN `checked_in_by_id`,
+  DROP COLUMN `checked_in_at`;
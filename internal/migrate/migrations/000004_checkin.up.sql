@@ -0,0 +1,5 @@
+-- 现场签到：记录签到时间和扫码的组织者
+
+ALTER TABLE `registrations`
+  ADD COLUMN `checked_in_at` datetime NULL DEFAULT NULL COMMENT '现场签到时间' AFTER `status`,
+  ADD COLUMN `checked_in_by_id` int NULL DEFAULT NULL COMMENT '扫码签到的组织者ID' AFTER `checked_in_at`;
@@ -0,0 +1,4 @@
+ALTER TABLE `registrations`
+  MODIFY COLUMN `status` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'pending' COMMENT '报名状态 (pending, approved, waitlisted)';
+
+DROP TABLE IF EXISTS `registration_status_history`;
@@ -0,0 +1,19 @@
+-- 报名状态机：记录每一次状态变更及原因
+
+CREATE TABLE `registration_status_history` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `registration_id` int NOT NULL COMMENT '报名记录ID',
+  `from_status` varchar(32) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NULL DEFAULT NULL COMMENT '变更前状态，新建报名时为空',
+  `to_status` varchar(32) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '变更后状态',
+  `reason` varchar(500) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NULL DEFAULT NULL COMMENT '变更原因，拒绝时必填',
+  `changed_by_id` int NULL DEFAULT NULL COMMENT '操作人ID，系统自动变更时为空',
+  `changed_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP COMMENT '变更时间',
+  PRIMARY KEY (`id`) USING BTREE,
+  INDEX `registration_id`(`registration_id` ASC) USING BTREE,
+  INDEX `changed_by_id`(`changed_by_id` ASC) USING BTREE,
+  CONSTRAINT `registration_status_history_ibfk_1` FOREIGN KEY (`registration_id`) REFERENCES `registrations` (`id`) ON DELETE CASCADE ON UPDATE RESTRICT,
+  CONSTRAINT `registration_status_history_ibfk_2` FOREIGN KEY (`changed_by_id`) REFERENCES `users` (`id`) ON DELETE SET NULL ON UPDATE RESTRICT
+) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_unicode_ci COMMENT = '报名状态变更历史表' ROW_FORMAT = DYNAMIC;
+
+ALTER TABLE `registrations`
+  MODIFY COLUMN `status` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'pending' COMMENT '报名状态 (pending, approved, rejected, cancelled, waitlisted, attended, no_show)';
@@ -0,0 +1,3 @@
+ALTER TABLE `activities`
+  DROP COLUMN `auto_approve_colleges`,
+  DROP COLUMN `approval_policy`;
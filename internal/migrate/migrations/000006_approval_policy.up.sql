@@ -0,0 +1,5 @@
+-- 每个活动的报名审核策略
+
+ALTER TABLE `activities`
+  ADD COLUMN `approval_policy` varchar(32) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'manual' COMMENT '报名审核策略 (manual, auto, auto_colleges)' AFTER `created_by_id`,
+  ADD COLUMN `auto_approve_colleges` text CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NULL COMMENT '自动通过的学院列表 (JSON 数组)' AFTER `approval_policy`;
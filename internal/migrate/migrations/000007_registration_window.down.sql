@@ -0,0 +1,4 @@
+ALTER TABLE `activities`
+  DROP COLUMN `cancellation_deadline`,
+  DROP COLUMN `registration_closes_at`,
+  DROP COLUMN `registration_opens_at`;
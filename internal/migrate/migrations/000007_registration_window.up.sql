@@ -0,0 +1,6 @@
+-- 报名开放、截止时间和取消报名截止时间
+
+ALTER TABLE `activities`
+  ADD COLUMN `registration_opens_at` datetime NULL DEFAULT NULL COMMENT '报名开始时间' AFTER `auto_approve_colleges`,
+  ADD COLUMN `registration_closes_at` datetime NULL DEFAULT NULL COMMENT '报名截止时间' AFTER `registration_opens_at`,
+  ADD COLUMN `cancellation_deadline` datetime NULL DEFAULT NULL COMMENT '取消报名截止时间' AFTER `registration_closes_at`;
@@ -0,0 +1,11 @@
+-- 活动报名资格规则
+
+CREATE TABLE `activity_eligibility_rules` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `activity_id` int NOT NULL COMMENT '活动ID',
+  `rule_type` varchar(32) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '规则类型 (college, username, role)',
+  `value` varchar(100) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '允许的学院 / 用户名模式 (* 为通配符) / 角色',
+  PRIMARY KEY (`id`) USING BTREE,
+  UNIQUE INDEX `activity_rule`(`activity_id` ASC, `rule_type` ASC, `value` ASC) USING BTREE,
+  CONSTRAINT `activity_eligibility_rules_ibfk_1` FOREIGN KEY (`activity_id`) REFERENCES `activities` (`id`) ON DELETE CASCADE ON UPDATE RESTRICT
+) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_unicode_ci COMMENT = '活动报名资格规则表' ROW_FORMAT = DYNAMIC;
@@ -0,0 +1,26 @@
+-- refresh token 轮换和 access token 吊销
+
+CREATE TABLE `refresh_tokens` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL COMMENT '用户ID',
+  `token_hash` char(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT 'refresh token 的 SHA-256 摘要',
+  `access_jti` varchar(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '同时签发的 access token 的 jti',
+  `access_expires_at` datetime NOT NULL COMMENT '同时签发的 access token 的过期时间',
+  `expires_at` datetime NOT NULL COMMENT '过期时间',
+  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP,
+  `revoked_at` datetime NULL DEFAULT NULL COMMENT '作废时间，轮换、退出登录或强制下线时写入',
+  `replaced_by_id` int NULL DEFAULT NULL COMMENT '轮换后的新记录ID',
+  PRIMARY KEY (`id`) USING BTREE,
+  UNIQUE INDEX `token_hash`(`token_hash` ASC) USING BTREE,
+  INDEX `user_id`(`user_id` ASC) USING BTREE,
+  CONSTRAINT `refresh_tokens_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE ON UPDATE RESTRICT
+) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_unicode_ci COMMENT = '刷新令牌表' ROW_FORMAT = DYNAMIC;
+
+CREATE TABLE `revoked_tokens` (
+  `jti` varchar(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '被吊销的 access token 的 jti',
+  `user_id` int NULL DEFAULT NULL COMMENT '用户ID',
+  `expires_at` datetime NOT NULL COMMENT 'token 过期时间，过期后的记录可以清理',
+  `revoked_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP COMMENT '吊销时间',
+  PRIMARY KEY (`jti`) USING BTREE,
+  INDEX `expires_at`(`expires_at` ASC) USING BTREE
+) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_unicode_ci COMMENT = 'access token 吊销列表' ROW_FORMAT = DYNAMIC;
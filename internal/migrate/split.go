@@ -0,0 +1,60 @@
+package migrate
+
+import "strings"
+
+// 把 SQL 脚本按分号拆分为单条语句，跳过注释；引号和反引号内的分号不作为分隔符
+func splitStatements(script string) []string {
+	statements := []string{}
+	var current strings.Builder
+	var quote byte // 当前所在的引号，0 表示不在引号内
+
+	flush := func() {
+		if stmt := strings.TrimSpace(current.String()); stmt != "" {
+			statements = append(statements, stmt)
+		}
+		current.Reset()
+	}
+
+	for i := 0; i < len(script); i++ {
+		ch := script[i]
+		if quote != 0 {
+			current.WriteByte(ch)
+			switch {
+			case ch == '\\' && quote != '`' && i+1 < len(script):
+				// 转义字符，原样保留下一个字符
+				i++
+				current.WriteByte(script[i])
+			case ch == quote:
+				quote = 0
+			}
+			continue
+		}
+
+		switch {
+		case ch == '\'' || ch == '"' || ch == '`':
+			quote = ch
+			current.WriteByte(ch)
+		case ch == '-' && strings.HasPrefix(script[i:], "--"):
+			// 行注释，跳到行尾，换行符保留
+			if end := strings.IndexByte(script[i:], '\n'); end >= 0 {
+				i += end - 1
+			} else {
+				i = len(script)
+			}
+		case ch == '/' && strings.HasPrefix(script[i:], "/*"):
+			// 块注释，替换为一个空格
+			current.WriteByte(' ')
+			if end := strings.Index(script[i+2:], "*/"); end >= 0 {
+				i += end + 3
+			} else {
+				i = len(script)
+			}
+		case ch == ';':
+			flush()
+		default:
+			current.WriteByte(ch)
+		}
+	}
+	flush()
+	return statements
+}
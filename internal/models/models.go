@@ -2,6 +2,31 @@ package models
 
 import "time"
 
+// 用户角色，与 users.role 字段取值一致
+const (
+	RoleStudent   = "student"   // 学生，可以报名活动
+	RoleOrganizer = "organizer" // 活动组织者，可以发布和管理活动
+	RoleAdmin     = "admin"     // 管理员，拥有全部权限
+)
+
+// 报名状态，与 registrations.status 字段取值一致
+const (
+	RegistrationPending    = "pending"    // 待审核
+	RegistrationApproved   = "approved"   // 已通过
+	RegistrationRejected   = "rejected"   // 已拒绝，需填写原因
+	RegistrationCancelled  = "cancelled"  // 已取消
+	RegistrationWaitlisted = "waitlisted" // 候补中
+	RegistrationAttended   = "attended"   // 已签到
+	RegistrationNoShow     = "no_show"    // 未到场
+)
+
+// 活动的报名审核策略
+const (
+	ApprovalManual       = "manual"        // 人工审核，报名后为待审核
+	ApprovalAuto         = "auto"          // 名额内自动通过
+	ApprovalAutoColleges = "auto_colleges" // 仅指定学院的学生在名额内自动通过，其余人工审核
+)
+
 // 用户视图模型
 type User struct {
 	ID           int    `json:"id"`
@@ -24,6 +49,31 @@ type Activity struct {
 	EndTime     time.Time `json:"endTime"`
 	Capacity    int       `json:"capacity"`
 	CreatedByID int       `json:"createdById"`
+
+	ApprovalPolicy      string   `json:"approvalPolicy"`      // 审核策略，取值见 Approval* 常量，默认 manual
+	AutoApproveColleges []string `json:"autoApproveColleges"` // approvalPolicy 为 auto_colleges 时自动通过的学院
+
+	RegistrationOpensAt  *time.Time `json:"registrationOpensAt"`  // 报名开始时间，为空表示发布后即可报名
+	RegistrationClosesAt *time.Time `json:"registrationClosesAt"` // 报名截止时间，为空表示活动结束前均可报名
+	CancellationDeadline *time.Time `json:"cancellationDeadline"` // 取消报名截止时间，为空表示活动开始前均可取消
+
+	Eligibility EligibilityRules `json:"eligibility"` // 报名资格限制，各项均为空表示不限
+}
+
+// 活动报名资格规则：同一类规则满足任意一条即可，不同类规则需要同时满足，某类为空表示该项不限
+type EligibilityRules struct {
+	Colleges         []string `json:"colleges"`         // 允许报名的学院
+	UsernamePatterns []string `json:"usernamePatterns"` // 允许的用户名（学号）模式，* 匹配任意字符，如 "2023*"
+	Roles            []string `json:"roles"`            // 允许报名的角色
+}
+
+// 活动列表分页响应模型
+type ActivityPage struct {
+	Items      []Activity `json:"items"`
+	Total      int        `json:"total"`      // 满足筛选条件的活动总数
+	Page       int        `json:"page"`       // 当前页码，从 1 开始
+	PageSize   int        `json:"pageSize"`   // 每页条数
+	TotalPages int        `json:"totalPages"` // 总页数
 }
 
 // 获取用户报名的所有活动的视图模型
@@ -33,15 +83,43 @@ type UserRegistration struct {
 	Title          string    `json:"title"`
 	Location       string    `json:"location"`
 	StartTime      time.Time `json:"startTime"`
+	Status         string    `json:"status"`
 }
 
 // 活动报名信息模型
 type Registration struct {
-	ID               int       `json:"id"`
-	UserID           int       `json:"userId"`
-	ActivityID       int       `json:"activityId"`
-	RegistrationTime time.Time `json:"registrationTime"`
-	Status           string    `json:"status"` // "pending", "approved"
+	ID               int        `json:"id"`
+	UserID           int        `json:"userId"`
+	ActivityID       int        `json:"activityId"`
+	RegistrationTime time.Time  `json:"registrationTime"`
+	Status           string     `json:"status"`      // 取值见 Registration* 常量
+	CheckedInAt      *time.Time `json:"checkedInAt"` // 签到时间，未签到为 null
+}
+
+// 批量修改报名状态时单条记录的处理结果
+type BulkStatusResult struct {
+	RegistrationID int    `json:"registrationId"`
+	Success        bool   `json:"success"`
+	Code           string `json:"code,omitempty"`  // 失败时的错误码，如 INVALID_STATUS_TRANSITION、ACTIVITY_FULL
+	Error          string `json:"error,omitempty"` // 失败原因
+}
+
+// 报名状态变更历史模型
+type RegistrationStatusChange struct {
+	ID          int       `json:"id"`
+	FromStatus  string    `json:"fromStatus"` // 新建报名时为空
+	ToStatus    string    `json:"toStatus"`
+	Reason      string    `json:"reason"`
+	ChangedByID *int      `json:"changedById"` // 系统自动变更（如候补递补）时为 null
+	ChangedAt   time.Time `json:"changedAt"`
+}
+
+// 用户在候补名单中的位次视图模型
+type WaitlistPosition struct {
+	RegistrationID int `json:"registrationId"`
+	ActivityID     int `json:"activityId"`
+	Position       int `json:"position"` // 从 1 开始
+	Total          int `json:"total"`    // 候补总人数
 }
 
 // 获取系统中所有用户的报名信息视图模型
@@ -58,11 +136,53 @@ type RegistrationDetails struct {
 
 // 根据活动 ID 获取该活动的所有报名者信息的视图模型
 type RegistrationDetailsForActivity struct {
-	RegistrationID   int       `json:"registrationId"` // 新增：报名记录ID
-	UserID           int       `json:"userId"`
-	Username         string    `json:"username"`
-	UserFullName     string    `json:"fullName"`
-	UserCollege      string    `json:"college"`
-	RegistrationTime time.Time `json:"registrationTime"`
-	Status           string    `json:"status"` // 新增：报名状态
+	RegistrationID   int        `json:"registrationId"` // 新增：报名记录ID
+	UserID           int        `json:"userId"`
+	Username         string     `json:"username"`
+	UserFullName     string     `json:"fullName"`
+	UserCollege      string     `json:"college"`
+	RegistrationTime time.Time  `json:"registrationTime"`
+	Status           string     `json:"status"`      // 新增：报名状态
+	CheckedIn        bool       `json:"checkedIn"`   // 是否已现场签到
+	CheckedInAt      *time.Time `json:"checkedInAt"` // 签到时间，未签到为 null
+}
+
+// 现场签到成功的结果模型
+type CheckinResult struct {
+	RegistrationID int       `json:"registrationId"`
+	UserID         int       `json:"userId"`
+	UserFullName   string    `json:"fullName"`
+	CheckedInAt    time.Time `json:"checkedInAt"`
+}
+
+// 批量导入用户时单行的处理结果
+type UserImportRow struct {
+	Row             int    `json:"row"` // 在文件中的行号，表头为第 1 行
+	Username        string `json:"username"`
+	Status          string `json:"status"` // "created", "skipped_duplicate", "invalid"
+	Reason          string `json:"reason,omitempty"`
+	InitialPassword string `json:"initialPassword,omitempty"` // 文件未提供密码时系统生成的初始密码
+}
+
+// 批量导入用户的结果报告
+type UserImportReport struct {
+	DryRun  bool            `json:"dryRun"` // 演练模式下只校验，不写入数据库
+	Total   int             `json:"total"`
+	Created int             `json:"created"`
+	Skipped int             `json:"skipped"`
+	Invalid int             `json:"invalid"`
+	Rows    []UserImportRow `json:"rows"`
+}
+
+// 热门活动排行视图模型
+type HotActivity struct {
+	Title             string `json:"title"`
+	Organizer         string `json:"organizer"`
+	RegistrationCount int    `json:"registrationCount"`
+}
+
+// 举办方活动数量统计视图模型
+type OrganizerStat struct {
+	Organizer     string `json:"organizer"`
+	ActivityCount int    `json:"activityCount"`
 }
@@ -0,0 +1,290 @@
+package store
+
+import (
+	"campus-activity-api/internal/logging"
+	"campus-activity-api/internal/models"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log/slog"
+	"strings"
+)
+
+type activityRepo struct{ s *SQLStore }
+
+// 查询活动时统一使用的列，顺序与 scanActivity 一一对应
+const activityColumns = `id, title, COALESCE(description, ''), COALESCE(category, ''), organizer, COALESCE(location, ''),
+	start_time, end_time, COALESCE(capacity, 0), created_by_id, approval_policy, auto_approve_colleges,
+	registration_opens_at, registration_closes_at, cancellation_deadline`
+
+// *sql.Row 和 *sql.Rows 共同的扫描接口
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// 按 activityColumns 的顺序扫描一行活动数据
+func scanActivity(row rowScanner, a *models.Activity) error {
+	var createdBy sql.NullInt64
+	var colleges sql.NullString
+	var opensAt, closesAt, cancelDeadline sql.NullTime
+	err := row.Scan(&a.ID, &a.Title, &a.Description, &a.Category, &a.Organizer, &a.Location,
+		&a.StartTime, &a.EndTime, &a.Capacity, &createdBy, &a.ApprovalPolicy, &colleges,
+		&opensAt, &closesAt, &cancelDeadline)
+	if err != nil {
+		return err
+	}
+	a.CreatedByID = int(createdBy.Int64)
+	a.AutoApproveColleges = decodeStringList(colleges)
+	a.RegistrationOpensAt = nullTimePtr(opensAt)
+	a.RegistrationClosesAt = nullTimePtr(closesAt)
+	a.CancellationDeadline = nullTimePtr(cancelDeadline)
+	return nil
+}
+
+// 列表字段以 JSON 数组的形式存储在 TEXT 列中，空列表存为 NULL
+func encodeStringList(list []string) sql.NullString {
+	if len(list) == 0 {
+		return sql.NullString{}
+	}
+	data, _ := json.Marshal(list)
+	return sql.NullString{String: string(data), Valid: true}
+}
+
+// 解析 JSON 数组形式存储的列表字段，无法解析时返回空列表
+func decodeStringList(value sql.NullString) []string {
+	list := []string{}
+	if value.Valid && value.String != "" {
+		if err := json.Unmarshal([]byte(value.String), &list); err != nil {
+			slog.Warn("解析列表字段失败", "error", err)
+			return []string{}
+		}
+	}
+	return list
+}
+
+func (r activityRepo) List(ctx context.Context, f ActivityFilter) ([]models.Activity, int, error) {
+	// 动态添加筛选条件和查询参数
+	conditions := []string{}
+	args := []interface{}{}
+	add := func(condition string, values ...interface{}) {
+		conditions = append(conditions, condition)
+		args = append(args, values...)
+	}
+
+	if f.Category != "" {
+		add("category = ?", f.Category)
+	}
+	if f.Organizer != "" {
+		add("organizer = ?", f.Organizer)
+	}
+	if f.Search != "" {
+		add("title LIKE ?", "%"+f.Search+"%")
+	}
+	if f.Location != "" {
+		add("location LIKE ?", "%"+f.Location+"%")
+	}
+	if f.StartFrom != nil {
+		add("start_time >= ?", *f.StartFrom)
+	}
+	if f.StartTo != nil {
+		add("start_time < ?", *f.StartTo)
+	}
+	if f.EndFrom != nil {
+		add("end_time >= ?", *f.EndFrom)
+	}
+	if f.EndTo != nil {
+		add("end_time < ?", *f.EndTo)
+	}
+	switch f.Status {
+	case ActivityUpcoming:
+		add("start_time > ?", f.Now)
+	case ActivityOngoing:
+		add("start_time <= ? AND end_time >= ?", f.Now, f.Now)
+	case ActivityPast:
+		add("end_time < ?", f.Now)
+	}
+	if f.EligibleFor != nil {
+		condition, conditionArgs := eligibleActivityCondition(*f.EligibleFor)
+		add(condition, conditionArgs...)
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	// 1. 先统计满足条件的总数
+	var total int
+	if err := r.s.q.QueryRowContext(ctx, "SELECT COUNT(*) FROM activities"+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	// 2. 查询当前页数据，再按 ID 排序保证分页顺序稳定
+	sortColumn, ok := activitySortColumns[f.Sort]
+	if !ok {
+		sortColumn = "start_time"
+	}
+	order := " ASC"
+	if f.Desc {
+		order = " DESC"
+	}
+	query := "SELECT " + activityColumns + " FROM activities" + where +
+		" ORDER BY " + sortColumn + order + ", id" + order + " LIMIT ? OFFSET ?"
+	rows, err := r.s.q.QueryContext(ctx, query, append(args, f.Limit, f.Offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	activities := []models.Activity{}
+	for rows.Next() {
+		var a models.Activity
+		if err := scanActivity(rows, &a); err != nil {
+			logging.FromContext(ctx).Error("扫描活动数据失败", "error", err)
+			continue
+		}
+		activities = append(activities, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	rows.Close()
+
+	// 3. 一次性读取当前页所有活动的报名资格规则
+	if err := r.attachEligibility(ctx, activities); err != nil {
+		return nil, 0, err
+	}
+	return activities, total, nil
+}
+
+func (r activityRepo) get(ctx context.Context, id int, lock bool) (models.Activity, error) {
+	query := "SELECT " + activityColumns + " FROM activities WHERE id = ?"
+	if lock {
+		query += r.s.dialect.forUpdate
+	}
+	var a models.Activity
+	if err := scanActivity(r.s.q.QueryRowContext(ctx, query, id), &a); err != nil {
+		return a, r.s.wrap(err)
+	}
+	activities := []models.Activity{a}
+	if err := r.attachEligibility(ctx, activities); err != nil {
+		return a, err
+	}
+	return activities[0], nil
+}
+
+func (r activityRepo) Get(ctx context.Context, id int) (models.Activity, error) {
+	return r.get(ctx, id, false)
+}
+
+func (r activityRepo) GetForUpdate(ctx context.Context, id int) (models.Activity, error) {
+	return r.get(ctx, id, true)
+}
+
+func (r activityRepo) Create(ctx context.Context, a *models.Activity) error {
+	result, err := r.s.q.ExecContext(ctx, `
+		INSERT INTO activities (title, description, category, organizer, location, start_time, end_time, capacity, created_by_id,
+			approval_policy, auto_approve_colleges, registration_opens_at, registration_closes_at, cancellation_deadline)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		a.Title, a.Description, a.Category, a.Organizer, a.Location, a.StartTime, a.EndTime, a.Capacity,
+		sql.NullInt64{Int64: int64(a.CreatedByID), Valid: a.CreatedByID != 0},
+		a.ApprovalPolicy, encodeStringList(a.AutoApproveColleges),
+		timePtrValue(a.RegistrationOpensAt), timePtrValue(a.RegistrationClosesAt), timePtrValue(a.CancellationDeadline),
+	)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	a.ID = int(id)
+	return r.saveEligibility(ctx, a.ID, a.Eligibility)
+}
+
+func (r activityRepo) Update(ctx context.Context, a *models.Activity) error {
+	_, err := r.s.q.ExecContext(ctx, `
+		UPDATE activities
+		SET title = ?, description = ?, category = ?, organizer = ?, location = ?, start_time = ?, end_time = ?, capacity = ?,
+			approval_policy = ?, auto_approve_colleges = ?,
+			registration_opens_at = ?, registration_closes_at = ?, cancellation_deadline = ?
+		WHERE id = ?`,
+		a.Title, a.Description, a.Category, a.Organizer, a.Location, a.StartTime, a.EndTime, a.Capacity,
+		a.ApprovalPolicy, encodeStringList(a.AutoApproveColleges),
+		timePtrValue(a.RegistrationOpensAt), timePtrValue(a.RegistrationClosesAt), timePtrValue(a.CancellationDeadline),
+		a.ID)
+	if err != nil {
+		return err
+	}
+	return r.saveEligibility(ctx, a.ID, a.Eligibility)
+}
+
+func (r activityRepo) Delete(ctx context.Context, id int) error {
+	_, err := r.s.q.ExecContext(ctx, "DELETE FROM activities WHERE id = ?", id)
+	return err
+}
+
+func (r activityRepo) OwnerID(ctx context.Context, id int) (int, error) {
+	var createdBy sql.NullInt64
+	err := r.s.q.QueryRowContext(ctx, "SELECT created_by_id FROM activities WHERE id = ?", id).Scan(&createdBy)
+	return int(createdBy.Int64), r.s.wrap(err)
+}
+
+// sql解析：
+// 1.`FROM activities AS a`：主表是 activities，别名为 a。
+// 2.`LEFT JOIN registrations AS r ON a.id = r.activity_id AND r.status IN (...)`：
+//
+//	只统计指定状态的报名；LEFT JOIN 保证即使某个活动没有报名，也会显示（报名数为 0）。
+//
+// 3.`COUNT(r.id) AS registration_count`：统计每个活动的报名人数。
+// 4.`GROUP BY a.id`：按照活动 ID 聚合数据，每个活动得到一行。
+// 5.`ORDER BY registration_count DESC`：按照报名人数降序排序，热门活动排在前面。
+func (r activityRepo) HotActivities(ctx context.Context, limit int, statuses []string) ([]models.HotActivity, error) {
+	in, args := inClause(statuses)
+	rows, err := r.s.q.QueryContext(ctx, `
+		SELECT a.title, a.organizer, COUNT(r.id) AS registration_count
+		FROM activities AS a
+		LEFT JOIN registrations AS r ON a.id = r.activity_id AND r.status IN `+in+`
+		GROUP BY a.id, a.title, a.organizer
+		ORDER BY registration_count DESC
+		LIMIT ?`, append(args, limit)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := []models.HotActivity{}
+	for rows.Next() {
+		var res models.HotActivity
+		if err := rows.Scan(&res.Title, &res.Organizer, &res.RegistrationCount); err != nil {
+			logging.FromContext(ctx).Error("扫描热门活动数据失败", "error", err)
+			continue
+		}
+		results = append(results, res)
+	}
+	return results, rows.Err()
+}
+
+// sql解析：按 organizer 分组统计活动数量，按数量从高到低排序，方便前端展示热门组织者
+func (r activityRepo) OrganizerStats(ctx context.Context) ([]models.OrganizerStat, error) {
+	rows, err := r.s.q.QueryContext(ctx, `
+		SELECT organizer, COUNT(id) AS activity_count
+		FROM activities
+		GROUP BY organizer
+		ORDER BY activity_count DESC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := []models.OrganizerStat{}
+	for rows.Next() {
+		var s models.OrganizerStat
+		if err := rows.Scan(&s.Organizer, &s.ActivityCount); err != nil {
+			logging.FromContext(ctx).Error("扫描组织方数据失败", "error", err)
+			continue
+		}
+		stats = append(stats, s)
+	}
+	return stats, rows.Err()
+}
@@ -0,0 +1,103 @@
+package store
+
+import (
+	"campus-activity-api/internal/models"
+	"context"
+	"strings"
+)
+
+// 资格规则的类型，对应 activity_eligibility_rules.rule_type
+const (
+	eligibilityCollege  = "college"
+	eligibilityUsername = "username"
+	eligibilityRole     = "role"
+)
+
+// 批量读取活动的资格规则并写入各活动，没有规则的活动各项为空列表
+func (r activityRepo) attachEligibility(ctx context.Context, activities []models.Activity) error {
+	if len(activities) == 0 {
+		return nil
+	}
+	ids := make([]int, len(activities))
+	index := make(map[int]int, len(activities))
+	for i := range activities {
+		ids[i] = activities[i].ID
+		index[activities[i].ID] = i
+		activities[i].Eligibility = models.EligibilityRules{Colleges: []string{}, UsernamePatterns: []string{}, Roles: []string{}}
+	}
+
+	in, args := inClause(ids)
+	rows, err := r.s.q.QueryContext(ctx,
+		"SELECT activity_id, rule_type, value FROM activity_eligibility_rules WHERE activity_id IN "+in+" ORDER BY id", args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var activityID int
+		var ruleType, value string
+		if err := rows.Scan(&activityID, &ruleType, &value); err != nil {
+			return err
+		}
+		rules := &activities[index[activityID]].Eligibility
+		switch ruleType {
+		case eligibilityCollege:
+			rules.Colleges = append(rules.Colleges, value)
+		case eligibilityUsername:
+			rules.UsernamePatterns = append(rules.UsernamePatterns, value)
+		case eligibilityRole:
+			rules.Roles = append(rules.Roles, value)
+		}
+	}
+	return rows.Err()
+}
+
+// 用新的规则整体替换活动原有的资格规则，调用方负责放进事务
+func (r activityRepo) saveEligibility(ctx context.Context, activityID int, rules models.EligibilityRules) error {
+	if _, err := r.s.q.ExecContext(ctx, "DELETE FROM activity_eligibility_rules WHERE activity_id = ?", activityID); err != nil {
+		return err
+	}
+	groups := []struct {
+		ruleType string
+		values   []string
+	}{
+		{eligibilityCollege, rules.Colleges},
+		{eligibilityUsername, rules.UsernamePatterns},
+		{eligibilityRole, rules.Roles},
+	}
+	for _, g := range groups {
+		for _, value := range g.values {
+			if _, err := r.s.q.ExecContext(ctx,
+				"INSERT INTO activity_eligibility_rules (activity_id, rule_type, value) VALUES (?, ?, ?)",
+				activityID, g.ruleType, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// 生成“用户满足活动报名资格”的 SQL 条件，用于活动列表的 eligibleFor=me 筛选
+// 语义与 handler 中的资格校验一致：某类规则不存在，或存在一条与用户匹配的规则
+func eligibleActivityCondition(user models.User) (string, []interface{}) {
+	ruleExists := "EXISTS (SELECT 1 FROM activity_eligibility_rules e WHERE e.activity_id = activities.id AND e.rule_type = ?"
+	matches := []struct {
+		ruleType string
+		clause   string
+		value    string
+	}{
+		{eligibilityCollege, "e.value = ?", user.College},
+		// 用户名模式中的 * 转换为 LIKE 的 %，模式本身不含 % 和 _
+		{eligibilityUsername, "? LIKE REPLACE(e.value, '*', '%')", user.Username},
+		{eligibilityRole, "e.value = ?", user.Role},
+	}
+
+	conditions := make([]string, 0, len(matches))
+	args := []interface{}{}
+	for _, m := range matches {
+		conditions = append(conditions, "(NOT "+ruleExists+") OR "+ruleExists+" AND "+m.clause+"))")
+		args = append(args, m.ruleType, m.ruleType, m.value)
+	}
+	return strings.Join(conditions, " AND "), args
+}
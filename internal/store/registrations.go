@@ -0,0 +1,272 @@
+package store
+
+import (
+	"campus-activity-api/internal/models"
+	"context"
+	"database/sql"
+	"time"
+)
+
+type registrationRepo struct{ s *SQLStore }
+
+const registrationColumns = "id, user_id, activity_id, registration_time, status, checked_in_at"
+
+func scanRegistration(row rowScanner, reg *models.Registration) error {
+	// registration_time 列允许为空，历史数据中可能存在 NULL
+	var registrationTime, checkedInAt sql.NullTime
+	if err := row.Scan(&reg.ID, &reg.UserID, &reg.ActivityID, &registrationTime, &reg.Status, &checkedInAt); err != nil {
+		return err
+	}
+	reg.RegistrationTime = registrationTime.Time
+	reg.CheckedInAt = nullTimePtr(checkedInAt)
+	return nil
+}
+
+func (r registrationRepo) get(ctx context.Context, where string, args ...interface{}) (models.Registration, error) {
+	var reg models.Registration
+	err := scanRegistration(r.s.q.QueryRowContext(ctx, "SELECT "+registrationColumns+" FROM registrations WHERE "+where, args...), &reg)
+	return reg, r.s.wrap(err)
+}
+
+func (r registrationRepo) Get(ctx context.Context, id int) (models.Registration, error) {
+	return r.get(ctx, "id = ?", id)
+}
+
+func (r registrationRepo) GetForUpdate(ctx context.Context, id int) (models.Registration, error) {
+	return r.get(ctx, "id = ?"+r.s.dialect.forUpdate, id)
+}
+
+func (r registrationRepo) FindForUpdate(ctx context.Context, userID, activityID int) (models.Registration, error) {
+	return r.get(ctx, "user_id = ? AND activity_id = ?"+r.s.dialect.forUpdate, userID, activityID)
+}
+
+func (r registrationRepo) NextWaitlistedForUpdate(ctx context.Context, activityID, excludeID int) (models.Registration, error) {
+	return r.get(ctx, "activity_id = ? AND status = ? AND id <> ? ORDER BY registration_time ASC, id ASC LIMIT 1"+r.s.dialect.forUpdate,
+		activityID, models.RegistrationWaitlisted, excludeID)
+}
+
+func (r registrationRepo) Create(ctx context.Context, reg *models.Registration) error {
+	if reg.RegistrationTime.IsZero() {
+		reg.RegistrationTime = time.Now()
+	}
+	result, err := r.s.q.ExecContext(ctx,
+		"INSERT INTO registrations (user_id, activity_id, registration_time, status) VALUES (?, ?, ?, ?)",
+		reg.UserID, reg.ActivityID, reg.RegistrationTime, reg.Status)
+	if err != nil {
+		return r.s.wrap(err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	reg.ID = int(id)
+	return nil
+}
+
+func (r registrationRepo) Reopen(ctx context.Context, id int, status string, registeredAt time.Time) error {
+	_, err := r.s.q.ExecContext(ctx,
+		"UPDATE registrations SET status = ?, registration_time = ?, checked_in_at = NULL, checked_in_by_id = NULL WHERE id = ?",
+		status, registeredAt, id)
+	return err
+}
+
+func (r registrationRepo) UpdateStatus(ctx context.Context, id int, status string) error {
+	_, err := r.s.q.ExecContext(ctx, "UPDATE registrations SET status = ? WHERE id = ?", status, id)
+	return err
+}
+
+// checked_in_at IS NULL 条件保证并发扫码时只有一次成功
+func (r registrationRepo) MarkCheckedIn(ctx context.Context, id int, at time.Time, byUserID int) (bool, error) {
+	result, err := r.s.q.ExecContext(ctx,
+		"UPDATE registrations SET checked_in_at = ?, checked_in_by_id = ? WHERE id = ? AND checked_in_at IS NULL",
+		at, byUserID, id)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+func (r registrationRepo) Delete(ctx context.Context, id int) error {
+	_, err := r.s.q.ExecContext(ctx, "DELETE FROM registrations WHERE id = ?", id)
+	return err
+}
+
+func (r registrationRepo) CountByStatus(ctx context.Context, activityID int, statuses []string) (int, error) {
+	in, args := inClause(statuses)
+	var count int
+	err := r.s.q.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM registrations WHERE activity_id = ? AND status IN "+in,
+		append([]interface{}{activityID}, args...)...).Scan(&count)
+	return count, err
+}
+
+func (r registrationRepo) IDsByStatus(ctx context.Context, activityID int, status string) ([]int, error) {
+	rows, err := r.s.q.QueryContext(ctx,
+		"SELECT id FROM registrations WHERE activity_id = ? AND status = ? ORDER BY registration_time ASC, id ASC",
+		activityID, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// 按报名时间排序，时间相同再按 ID 排序，排在自己前面的人数 + 1 即为位次
+func (r registrationRepo) WaitlistPosition(ctx context.Context, userID, activityID int) (models.WaitlistPosition, error) {
+	pos := models.WaitlistPosition{ActivityID: activityID}
+	var registrationTime sql.NullTime
+	err := r.s.q.QueryRowContext(ctx,
+		"SELECT id, registration_time FROM registrations WHERE user_id = ? AND activity_id = ? AND status = ?",
+		userID, activityID, models.RegistrationWaitlisted).Scan(&pos.RegistrationID, &registrationTime)
+	if err != nil {
+		return pos, r.s.wrap(err)
+	}
+
+	var ahead int
+	err = r.s.q.QueryRowContext(ctx, `
+		SELECT
+			COUNT(*),
+			COALESCE(SUM(CASE WHEN registration_time < ? OR (registration_time = ? AND id < ?) THEN 1 ELSE 0 END), 0)
+		FROM registrations
+		WHERE activity_id = ? AND status = ?`,
+		registrationTime, registrationTime, pos.RegistrationID, activityID, models.RegistrationWaitlisted).
+		Scan(&pos.Total, &ahead)
+	if err != nil {
+		return pos, err
+	}
+	pos.Position = ahead + 1
+	return pos, nil
+}
+
+func (r registrationRepo) RecordPromotion(ctx context.Context, registrationID, activityID, userID int) error {
+	_, err := r.s.q.ExecContext(ctx,
+		"INSERT INTO waitlist_promotions (registration_id, activity_id, user_id) VALUES (?, ?, ?)",
+		registrationID, activityID, userID)
+	return err
+}
+
+func (r registrationRepo) RecordStatusChange(ctx context.Context, registrationID int, from, to, reason string, actorID int) error {
+	_, err := r.s.q.ExecContext(ctx,
+		"INSERT INTO registration_status_history (registration_id, from_status, to_status, reason, changed_by_id) VALUES (?, ?, ?, ?, ?)",
+		registrationID,
+		sql.NullString{String: from, Valid: from != ""},
+		to,
+		sql.NullString{String: reason, Valid: reason != ""},
+		sql.NullInt64{Int64: int64(actorID), Valid: actorID != 0},
+	)
+	return err
+}
+
+func (r registrationRepo) History(ctx context.Context, registrationID int) ([]models.RegistrationStatusChange, error) {
+	rows, err := r.s.q.QueryContext(ctx, `
+		SELECT id, from_status, to_status, reason, changed_by_id, changed_at
+		FROM registration_status_history
+		WHERE registration_id = ?
+		ORDER BY changed_at ASC, id ASC`, registrationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := []models.RegistrationStatusChange{}
+	for rows.Next() {
+		var h models.RegistrationStatusChange
+		var from, reason sql.NullString
+		var changedBy sql.NullInt64
+		if err := rows.Scan(&h.ID, &from, &h.ToStatus, &reason, &changedBy, &h.ChangedAt); err != nil {
+			return nil, err
+		}
+		h.FromStatus = from.String
+		h.Reason = reason.String
+		if changedBy.Valid {
+			id := int(changedBy.Int64)
+			h.ChangedByID = &id
+		}
+		history = append(history, h)
+	}
+	return history, rows.Err()
+}
+
+func (r registrationRepo) ListAll(ctx context.Context) ([]models.RegistrationDetails, error) {
+	rows, err := r.s.q.QueryContext(ctx, `
+		SELECT r.id, r.activity_id, a.title, r.user_id, COALESCE(u.full_name, ''), COALESCE(u.college, ''), r.registration_time, r.status
+		FROM registrations r
+		JOIN users u ON r.user_id = u.id
+		JOIN activities a ON r.activity_id = a.id
+		ORDER BY r.registration_time DESC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	registrations := []models.RegistrationDetails{}
+	for rows.Next() {
+		var reg models.RegistrationDetails
+		if err := rows.Scan(&reg.RegistrationID, &reg.ActivityID, &reg.ActivityTitle, &reg.UserID,
+			&reg.UserFullName, &reg.UserCollege, &reg.RegistrationTime, &reg.Status); err != nil {
+			return nil, err
+		}
+		registrations = append(registrations, reg)
+	}
+	return registrations, rows.Err()
+}
+
+func (r registrationRepo) ListByActivity(ctx context.Context, activityID int) ([]models.RegistrationDetailsForActivity, error) {
+	rows, err := r.s.q.QueryContext(ctx, `
+		SELECT r.id, u.id, u.username, COALESCE(u.full_name, ''), COALESCE(u.college, ''), r.registration_time, r.status, r.checked_in_at
+		FROM registrations r
+		JOIN users u ON r.user_id = u.id
+		WHERE r.activity_id = ?
+		ORDER BY r.registration_time ASC, r.id ASC`, activityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	registrants := []models.RegistrationDetailsForActivity{}
+	for rows.Next() {
+		var reg models.RegistrationDetailsForActivity
+		var checkedInAt sql.NullTime
+		if err := rows.Scan(&reg.RegistrationID, &reg.UserID, &reg.Username, &reg.UserFullName, &reg.UserCollege,
+			&reg.RegistrationTime, &reg.Status, &checkedInAt); err != nil {
+			return nil, err
+		}
+		reg.CheckedInAt = nullTimePtr(checkedInAt)
+		reg.CheckedIn = reg.CheckedInAt != nil
+		registrants = append(registrants, reg)
+	}
+	return registrants, rows.Err()
+}
+
+func (r registrationRepo) ListByUser(ctx context.Context, userID int) ([]models.UserRegistration, error) {
+	rows, err := r.s.q.QueryContext(ctx, `
+		SELECT r.id, a.id, a.title, COALESCE(a.location, ''), a.start_time, r.status
+		FROM registrations r
+		JOIN activities a ON r.activity_id = a.id
+		WHERE r.user_id = ?
+		ORDER BY a.start_time DESC`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	registrations := []models.UserRegistration{}
+	for rows.Next() {
+		var reg models.UserRegistration
+		if err := rows.Scan(&reg.RegistrationID, &reg.ActivityID, &reg.Title, &reg.Location, &reg.StartTime, &reg.Status); err != nil {
+			return nil, err
+		}
+		registrations = append(registrations, reg)
+	}
+	return registrations, rows.Err()
+}
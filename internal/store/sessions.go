@@ -0,0 +1,144 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+type sessionRepo struct{ s *SQLStore }
+
+func (r sessionRepo) CreateRefreshToken(ctx context.Context, t *RefreshToken) error {
+	result, err := r.s.q.ExecContext(ctx, `
+		INSERT INTO refresh_tokens (user_id, token_hash, access_jti, access_expires_at, expires_at)
+		VALUES (?, ?, ?, ?, ?)`,
+		t.UserID, t.TokenHash, t.AccessJTI, t.AccessExpiresAt, t.ExpiresAt)
+	if err != nil {
+		return r.s.wrap(err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	t.ID = int(id)
+	return nil
+}
+
+func (r sessionRepo) GetRefreshTokenForUpdate(ctx context.Context, tokenHash string) (RefreshToken, error) {
+	t := RefreshToken{TokenHash: tokenHash}
+	var revokedAt sql.NullTime
+	err := r.s.q.QueryRowContext(ctx, `
+		SELECT id, user_id, access_jti, access_expires_at, expires_at, revoked_at
+		FROM refresh_tokens WHERE token_hash = ?`+r.s.dialect.forUpdate, tokenHash).
+		Scan(&t.ID, &t.UserID, &t.AccessJTI, &t.AccessExpiresAt, &t.ExpiresAt, &revokedAt)
+	t.RevokedAt = nullTimePtr(revokedAt)
+	return t, r.s.wrap(err)
+}
+
+func (r sessionRepo) MarkRefreshTokenReplaced(ctx context.Context, id, replacedByID int, at time.Time) error {
+	_, err := r.s.q.ExecContext(ctx,
+		"UPDATE refresh_tokens SET revoked_at = ?, replaced_by_id = ? WHERE id = ?", at, replacedByID, id)
+	return err
+}
+
+func (r sessionRepo) RevokeRefreshTokenByAccessJTI(ctx context.Context, userID int, jti string, at time.Time) error {
+	_, err := r.s.q.ExecContext(ctx,
+		"UPDATE refresh_tokens SET revoked_at = ? WHERE user_id = ? AND access_jti = ? AND revoked_at IS NULL",
+		at, userID, jti)
+	return err
+}
+
+func (r sessionRepo) RevokeRefreshTokens(ctx context.Context, userID int, at time.Time) (int, error) {
+	result, err := r.s.q.ExecContext(ctx,
+		"UPDATE refresh_tokens SET revoked_at = ? WHERE user_id = ? AND revoked_at IS NULL", at, userID)
+	if err != nil {
+		return 0, err
+	}
+	n, err := result.RowsAffected()
+	return int(n), err
+}
+
+func (r sessionRepo) ActiveAccessTokens(ctx context.Context, userID int, now time.Time) ([]AccessToken, error) {
+	rows, err := r.s.q.QueryContext(ctx,
+		"SELECT access_jti, access_expires_at FROM refresh_tokens WHERE user_id = ? AND access_expires_at > ?",
+		userID, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tokens []AccessToken
+	for rows.Next() {
+		var t AccessToken
+		if err := rows.Scan(&t.JTI, &t.ExpiresAt); err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, t)
+	}
+	return tokens, rows.Err()
+}
+
+func (r sessionRepo) RevokeAccessToken(ctx context.Context, jti string, userID int, expiresAt time.Time) error {
+	_, err := r.s.q.ExecContext(ctx,
+		"INSERT INTO revoked_tokens (jti, user_id, expires_at) VALUES (?, ?, ?)", jti, userID, expiresAt)
+	// 重复吊销同一个 token 不算错误
+	if err = r.s.wrap(err); errors.Is(err, ErrDuplicate) {
+		return nil
+	}
+	return err
+}
+
+func (r sessionRepo) RevokedAccessTokens(ctx context.Context, now time.Time) (map[string]time.Time, error) {
+	rows, err := r.s.q.QueryContext(ctx, "SELECT jti, expires_at FROM revoked_tokens WHERE expires_at > ?", now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := map[string]time.Time{}
+	for rows.Next() {
+		var jti string
+		var expiresAt time.Time
+		if err := rows.Scan(&jti, &expiresAt); err != nil {
+			return nil, err
+		}
+		entries[jti] = expiresAt
+	}
+	return entries, rows.Err()
+}
+
+func (r sessionRepo) ReplacePasswordResetToken(ctx context.Context, t *PasswordResetToken) error {
+	if _, err := r.s.q.ExecContext(ctx,
+		"DELETE FROM password_reset_tokens WHERE user_id = ? AND used_at IS NULL", t.UserID); err != nil {
+		return err
+	}
+	result, err := r.s.q.ExecContext(ctx,
+		"INSERT INTO password_reset_tokens (user_id, token_hash, expires_at, created_by_id) VALUES (?, ?, ?, ?)",
+		t.UserID, t.TokenHash, t.ExpiresAt, t.CreatedByID)
+	if err != nil {
+		return r.s.wrap(err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	t.ID = int(id)
+	return nil
+}
+
+func (r sessionRepo) GetPasswordResetTokenForUpdate(ctx context.Context, tokenHash string) (PasswordResetToken, error) {
+	t := PasswordResetToken{TokenHash: tokenHash}
+	var createdBy sql.NullInt64
+	err := r.s.q.QueryRowContext(ctx, `
+		SELECT id, user_id, expires_at, created_by_id
+		FROM password_reset_tokens WHERE token_hash = ? AND used_at IS NULL`+r.s.dialect.forUpdate, tokenHash).
+		Scan(&t.ID, &t.UserID, &t.ExpiresAt, &createdBy)
+	t.CreatedByID = int(createdBy.Int64)
+	return t, r.s.wrap(err)
+}
+
+func (r sessionRepo) MarkPasswordResetTokenUsed(ctx context.Context, id int, at time.Time) error {
+	_, err := r.s.q.ExecContext(ctx, "UPDATE password_reset_tokens SET used_at = ? WHERE id = ?", at, id)
+	return err
+}
@@ -0,0 +1,125 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// *sql.DB 和 *sql.Tx 共有的方法，仓储在事务内外使用同一套代码
+type DBTX interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// 不同数据库在 SQL 上的差异
+type dialect struct {
+	forUpdate   string           // 行锁后缀，不支持 SELECT ... FOR UPDATE 的数据库为空
+	isDuplicate func(error) bool // 判断错误是否为违反唯一约束
+}
+
+// MySQL 的唯一约束冲突错误码
+const mysqlErrDuplicateEntry = 1062
+
+var mysqlDialect = dialect{
+	forUpdate: " FOR UPDATE",
+	isDuplicate: func(err error) bool {
+		var mysqlErr *mysql.MySQLError
+		return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry
+	},
+}
+
+// SQLite 的事务本身是串行的，不支持也不需要行锁；唯一约束冲突只能通过错误信息判断
+var sqliteDialect = dialect{
+	forUpdate: "",
+	isDuplicate: func(err error) bool {
+		return strings.Contains(err.Error(), "UNIQUE constraint failed")
+	},
+}
+
+// 基于 database/sql 的 Store 实现
+type SQLStore struct {
+	db      *sql.DB
+	q       DBTX // 事务外为 db，事务内为当前事务
+	inTx    bool
+	dialect dialect
+}
+
+// 创建使用 MySQL 的 Store
+func NewMySQL(db *sql.DB) *SQLStore {
+	return &SQLStore{db: db, q: db, dialect: mysqlDialect}
+}
+
+// 创建使用 SQLite 的 Store，SQLite 的驱动和表结构见 store/sqlite 包
+func NewSQLite(db *sql.DB) *SQLStore {
+	return &SQLStore{db: db, q: db, dialect: sqliteDialect}
+}
+
+func (s *SQLStore) Users() UserRepository                 { return userRepo{s} }
+func (s *SQLStore) Activities() ActivityRepository        { return activityRepo{s} }
+func (s *SQLStore) Registrations() RegistrationRepository { return registrationRepo{s} }
+func (s *SQLStore) Sessions() SessionRepository           { return sessionRepo{s} }
+
+func (s *SQLStore) WithTx(ctx context.Context, fn func(tx Store) error) error {
+	if s.inTx {
+		return fn(s)
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	// Commit 之后再调用 Rollback 不会有任何影响
+	defer tx.Rollback()
+
+	if err := fn(&SQLStore{db: s.db, q: tx, inTx: true, dialect: s.dialect}); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// 把驱动返回的错误转换为仓储层的错误
+func (s *SQLStore) wrap(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, sql.ErrNoRows):
+		return ErrNotFound
+	case s.dialect.isDuplicate(err):
+		return ErrDuplicate
+	}
+	return err
+}
+
+// 可为空的时间列转换为指针，NULL 对应 nil
+func nullTimePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+// 时间指针转换为可写入数据库的值，nil 写入 NULL
+func timePtrValue(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+// 生成 IN (?, ?, ...) 的占位符和参数
+func inClause[T any](values []T) (string, []interface{}) {
+	args := make([]interface{}, len(values))
+	for i, v := range values {
+		args[i] = v
+	}
+	placeholders := "?"
+	for i := 1; i < len(values); i++ {
+		placeholders += ", ?"
+	}
+	return "(" + placeholders + ")", args
+}
@@ -0,0 +1,105 @@
+-- SQLite 版表结构，用于测试和本地开发，与 internal/migrate/migrations 中的 MySQL 迁移保持一致
+-- 新增迁移时需要同步修改这里，internal/migrate 中的 TestSQLiteSchemaMatchesMigrations 会比对两边的表和列；
+-- 时间列声明为 DATETIME / TIMESTAMP，驱动读取时会解析为 time.Time
+
+CREATE TABLE users (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  username TEXT NOT NULL COLLATE NOCASE UNIQUE,
+  password_hash TEXT NOT NULL,
+  full_name TEXT NULL DEFAULT NULL,
+  college TEXT NULL DEFAULT NULL,
+  role TEXT NOT NULL DEFAULT 'student',
+  created_at TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE activities (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  title TEXT NOT NULL,
+  description TEXT NULL,
+  category TEXT NULL DEFAULT NULL,
+  organizer TEXT NOT NULL,
+  location TEXT NULL DEFAULT NULL,
+  start_time DATETIME NOT NULL,
+  end_time DATETIME NOT NULL,
+  capacity INTEGER NULL DEFAULT 0,
+  created_by_id INTEGER NULL DEFAULT NULL REFERENCES users (id) ON DELETE SET NULL,
+  approval_policy TEXT NOT NULL DEFAULT 'manual',
+  auto_approve_colleges TEXT NULL,
+  registration_opens_at DATETIME NULL DEFAULT NULL,
+  registration_closes_at DATETIME NULL DEFAULT NULL,
+  cancellation_deadline DATETIME NULL DEFAULT NULL,
+  created_at TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX activities_created_by_id ON activities (created_by_id);
+
+CREATE TABLE activity_eligibility_rules (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  activity_id INTEGER NOT NULL REFERENCES activities (id) ON DELETE CASCADE,
+  rule_type TEXT NOT NULL,
+  value TEXT NOT NULL COLLATE NOCASE,
+  UNIQUE (activity_id, rule_type, value)
+);
+
+CREATE TABLE registrations (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  activity_id INTEGER NOT NULL REFERENCES activities (id) ON DELETE CASCADE,
+  registration_time TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP,
+  status TEXT NOT NULL DEFAULT 'pending',
+  checked_in_at DATETIME NULL DEFAULT NULL,
+  checked_in_by_id INTEGER NULL DEFAULT NULL,
+  UNIQUE (user_id, activity_id)
+);
+CREATE INDEX registrations_activity_id ON registrations (activity_id);
+
+CREATE TABLE registration_status_history (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  registration_id INTEGER NOT NULL REFERENCES registrations (id) ON DELETE CASCADE,
+  from_status TEXT NULL DEFAULT NULL,
+  to_status TEXT NOT NULL,
+  reason TEXT NULL DEFAULT NULL,
+  changed_by_id INTEGER NULL DEFAULT NULL REFERENCES users (id) ON DELETE SET NULL,
+  changed_at TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX registration_status_history_registration_id ON registration_status_history (registration_id);
+
+CREATE TABLE waitlist_promotions (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  registration_id INTEGER NOT NULL REFERENCES registrations (id) ON DELETE CASCADE,
+  activity_id INTEGER NOT NULL REFERENCES activities (id) ON DELETE CASCADE,
+  user_id INTEGER NOT NULL,
+  promoted_at TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX waitlist_promotions_activity_id ON waitlist_promotions (activity_id);
+
+CREATE TABLE refresh_tokens (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  token_hash TEXT NOT NULL UNIQUE,
+  access_jti TEXT NOT NULL,
+  access_expires_at DATETIME NOT NULL,
+  expires_at DATETIME NOT NULL,
+  created_at TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP,
+  revoked_at DATETIME NULL DEFAULT NULL,
+  replaced_by_id INTEGER NULL DEFAULT NULL
+);
+CREATE INDEX refresh_tokens_user_id ON refresh_tokens (user_id);
+
+CREATE TABLE password_reset_tokens (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  token_hash TEXT NOT NULL UNIQUE,
+  expires_at DATETIME NOT NULL,
+  used_at DATETIME NULL DEFAULT NULL,
+  created_by_id INTEGER NULL DEFAULT NULL REFERENCES users (id) ON DELETE SET NULL,
+  created_at TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX password_reset_tokens_user_id ON password_reset_tokens (user_id);
+
+CREATE TABLE revoked_tokens (
+  jti TEXT NOT NULL PRIMARY KEY,
+  user_id INTEGER NULL DEFAULT NULL,
+  expires_at DATETIME NOT NULL,
+  revoked_at TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX revoked_tokens_expires_at ON revoked_tokens (expires_at);
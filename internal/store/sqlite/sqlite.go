@@ -0,0 +1,47 @@
+// SQLite 后端：使用纯 Go 实现的驱动，不依赖 cgo 和外部数据库，用于测试和本地开发
+// 只有测试和带 sqlite 构建标签的二进制会引用这个包，默认构建的二进制不包含 SQLite 驱动
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"strings"
+
+	_ "modernc.org/sqlite"
+)
+
+//go:embed schema.sql
+var schema string
+
+// 打开 SQLite 数据库并在空库中建表，dsn 为文件路径或 ":memory:"，返回的连接通过 store.NewSQLite 使用
+// 连接参数中会追加开启外键约束，并统一时间的写入格式，保证时间列可以按字符串比较
+func Open(ctx context.Context, dsn string) (*sql.DB, error) {
+	sep := "?"
+	if strings.Contains(dsn, "?") {
+		sep = "&"
+	}
+	db, err := sql.Open("sqlite", dsn+sep+"_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_time_format=sqlite")
+	if err != nil {
+		return nil, err
+	}
+	// SQLite 同一时间只允许一个写事务，内存数据库的每个连接又是独立的库，因此只使用一个连接
+	db.SetMaxOpenConns(1)
+
+	if err := migrate(ctx, db); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// users 表不存在时执行建表语句
+func migrate(ctx context.Context, db *sql.DB) error {
+	var count int
+	err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'users'").Scan(&count)
+	if err != nil || count > 0 {
+		return err
+	}
+	_, err = db.ExecContext(ctx, schema)
+	return err
+}
@@ -0,0 +1,199 @@
+// 数据访问层：handler 通过仓储接口读写数据，不直接拼写 SQL
+// 业务规则（状态机、容量、资格校验等）仍在 handler 中，这里只负责持久化；
+// 需要多条语句原子执行时通过 Store.WithTx 显式开启事务
+package store
+
+import (
+	"campus-activity-api/internal/models"
+	"context"
+	"errors"
+	"time"
+)
+
+// 仓储层统一返回的错误，调用方用 errors.Is 判断
+var (
+	ErrNotFound  = errors.New("record not found") // 记录不存在
+	ErrDuplicate = errors.New("duplicate record") // 违反唯一约束，如用户名已存在、重复报名
+)
+
+// 所有仓储的入口
+type Store interface {
+	Users() UserRepository
+	Activities() ActivityRepository
+	Registrations() RegistrationRepository
+	Sessions() SessionRepository
+
+	// 在事务中执行 fn，fn 中应只通过参数 tx 访问数据；fn 返回错误时回滚，否则提交
+	// 已经在事务中时直接复用当前事务，不会开启嵌套事务
+	WithTx(ctx context.Context, fn func(tx Store) error) error
+}
+
+// 用户
+type UserRepository interface {
+	// 新建用户并回填 ID，用户名已存在时返回 ErrDuplicate
+	Create(ctx context.Context, user *models.User) error
+	// 在同一批语句中新建多个用户，任一用户名已存在时返回 ErrDuplicate；需要整体回滚时由调用方放进事务
+	CreateBatch(ctx context.Context, users []models.User) error
+	// 按 ID 查询，包含密码哈希
+	Get(ctx context.Context, id int) (models.User, error)
+	// 按 ID 查询并锁定用户行，用于修改密码等需要串行化的操作
+	GetForUpdate(ctx context.Context, id int) (models.User, error)
+	GetByUsername(ctx context.Context, username string) (models.User, error)
+	UpdatePasswordHash(ctx context.Context, id int, passwordHash string) error
+	// 返回 usernames 中已被占用的用户名
+	ExistingUsernames(ctx context.Context, usernames []string) (map[string]bool, error)
+}
+
+// 活动，读取的活动都带有报名资格规则
+type ActivityRepository interface {
+	// 按条件分页查询，返回当前页数据和满足条件的总数
+	List(ctx context.Context, filter ActivityFilter) ([]models.Activity, int, error)
+	Get(ctx context.Context, id int) (models.Activity, error)
+	// 查询并锁定活动行；同一活动的报名、审核、改容量都先锁活动行，再锁报名行
+	GetForUpdate(ctx context.Context, id int) (models.Activity, error)
+	// 新建活动并回填 ID，同时写入报名资格规则
+	Create(ctx context.Context, activity *models.Activity) error
+	// 更新活动的全部可修改字段，报名资格规则整体替换
+	Update(ctx context.Context, activity *models.Activity) error
+	Delete(ctx context.Context, id int) error
+	// 活动发布者的用户 ID，没有记录发布者时为 0
+	OwnerID(ctx context.Context, id int) (int, error)
+
+	// 按处于 statuses 状态的报名数排序的前 limit 个活动
+	HotActivities(ctx context.Context, limit int, statuses []string) ([]models.HotActivity, error)
+	// 每个举办方的活动数量
+	OrganizerStats(ctx context.Context) ([]models.OrganizerStat, error)
+}
+
+// 报名记录及其状态历史、候补递补记录
+type RegistrationRepository interface {
+	Get(ctx context.Context, id int) (models.Registration, error)
+	GetForUpdate(ctx context.Context, id int) (models.Registration, error)
+	// 查询并锁定用户在某活动下的报名记录
+	FindForUpdate(ctx context.Context, userID, activityID int) (models.Registration, error)
+	// 新建报名记录并回填 ID 和报名时间，重复报名时返回 ErrDuplicate
+	Create(ctx context.Context, registration *models.Registration) error
+	// 已取消的报名重新报名：更新状态和报名时间，清空签到信息
+	Reopen(ctx context.Context, id int, status string, registeredAt time.Time) error
+	UpdateStatus(ctx context.Context, id int, status string) error
+	// 记录签到时间，已签到过时返回 false
+	MarkCheckedIn(ctx context.Context, id int, at time.Time, byUserID int) (bool, error)
+	Delete(ctx context.Context, id int) error
+
+	// 统计某活动下处于 statuses 状态的报名数
+	CountByStatus(ctx context.Context, activityID int, statuses []string) (int, error)
+	// 某活动下处于指定状态的报名 ID，按报名时间先后排序
+	IDsByStatus(ctx context.Context, activityID int, status string) ([]int, error)
+	// 锁定并返回某活动候补名单中排在最前的报名，跳过 excludeID（为 0 时不跳过），候补名单为空时返回 ErrNotFound
+	NextWaitlistedForUpdate(ctx context.Context, activityID, excludeID int) (models.Registration, error)
+	// 用户在某活动候补名单中的位次，不在候补名单中时返回 ErrNotFound
+	WaitlistPosition(ctx context.Context, userID, activityID int) (models.WaitlistPosition, error)
+	RecordPromotion(ctx context.Context, registrationID, activityID, userID int) error
+
+	// 写入一条状态历史，from 为空表示新建报名，actorID 为 0 表示系统操作
+	RecordStatusChange(ctx context.Context, registrationID int, from, to, reason string, actorID int) error
+	// 按时间顺序返回状态历史
+	History(ctx context.Context, registrationID int) ([]models.RegistrationStatusChange, error)
+
+	ListAll(ctx context.Context) ([]models.RegistrationDetails, error)
+	ListByActivity(ctx context.Context, activityID int) ([]models.RegistrationDetailsForActivity, error)
+	ListByUser(ctx context.Context, userID int) ([]models.UserRegistration, error)
+}
+
+// 登录会话相关的令牌：refresh token、已吊销的 access token、密码重置令牌
+// 令牌本身只以 SHA-256 摘要的形式存储
+type SessionRepository interface {
+	// 新建 refresh token 记录并回填 ID
+	CreateRefreshToken(ctx context.Context, token *RefreshToken) error
+	// 按摘要查询并锁定 refresh token 记录，包括已作废的
+	GetRefreshTokenForUpdate(ctx context.Context, tokenHash string) (RefreshToken, error)
+	// 轮换后把旧记录标记为已被 replacedByID 替换
+	MarkRefreshTokenReplaced(ctx context.Context, id, replacedByID int, at time.Time) error
+	// 作废与某个 access token 配对的 refresh token
+	RevokeRefreshTokenByAccessJTI(ctx context.Context, userID int, jti string, at time.Time) error
+	// 作废用户所有未作废的 refresh token，返回作废的数量
+	RevokeRefreshTokens(ctx context.Context, userID int, at time.Time) (int, error)
+	// 用户在 now 时仍有效的 access token（按 refresh token 记录中的配对信息）
+	ActiveAccessTokens(ctx context.Context, userID int, now time.Time) ([]AccessToken, error)
+
+	// 把 access token 加入吊销列表，重复吊销不算错误
+	RevokeAccessToken(ctx context.Context, jti string, userID int, expiresAt time.Time) error
+	// 所有在 now 时尚未过期的已吊销 access token，jti -> 过期时间
+	RevokedAccessTokens(ctx context.Context, now time.Time) (map[string]time.Time, error)
+
+	// 为用户写入新的密码重置令牌，同时作废该用户之前未使用的令牌
+	ReplacePasswordResetToken(ctx context.Context, token *PasswordResetToken) error
+	// 按摘要查询并锁定未使用的密码重置令牌
+	GetPasswordResetTokenForUpdate(ctx context.Context, tokenHash string) (PasswordResetToken, error)
+	MarkPasswordResetTokenUsed(ctx context.Context, id int, at time.Time) error
+}
+
+// 活动状态筛选条件的取值
+const (
+	ActivityUpcoming = "upcoming" // 未开始
+	ActivityOngoing  = "ongoing"  // 进行中
+	ActivityPast     = "past"     // 已结束
+)
+
+// 活动列表的查询条件，零值字段表示不筛选
+type ActivityFilter struct {
+	Category  string // 精确匹配
+	Organizer string // 精确匹配
+	Search    string // 标题模糊匹配
+	Location  string // 地点模糊匹配
+
+	// 开始时间 / 结束时间的范围，均为左闭右开区间
+	StartFrom, StartTo, EndFrom, EndTo *time.Time
+
+	Status string    // 取值见 Activity* 常量，相对于 Now 判断
+	Now    time.Time // Status 不为空时必填
+
+	EligibleFor *models.User // 只返回该用户有资格报名的活动
+
+	Sort   string // 排序字段，合法取值见 ValidActivitySort，为空时按开始时间
+	Desc   bool
+	Limit  int
+	Offset int
+}
+
+// 活动列表允许排序的字段，前端字段名 -> 数据库列名，避免把用户输入直接拼进 ORDER BY
+var activitySortColumns = map[string]string{
+	"startTime": "start_time",
+	"endTime":   "end_time",
+	"title":     "title",
+	"capacity":  "capacity",
+	"createdAt": "created_at",
+	"id":        "id",
+}
+
+// 判断是否是活动列表支持的排序字段
+func ValidActivitySort(field string) bool {
+	_, ok := activitySortColumns[field]
+	return ok
+}
+
+// refresh token 记录
+type RefreshToken struct {
+	ID              int
+	UserID          int
+	TokenHash       string
+	AccessJTI       string    // 同一次签发的 access token，强制下线时据此吊销
+	AccessExpiresAt time.Time // 配对的 access token 的过期时间
+	ExpiresAt       time.Time
+	RevokedAt       *time.Time // 退出登录、被轮换或被强制下线的时间
+}
+
+// 仍在有效期内的 access token
+type AccessToken struct {
+	JTI       string
+	ExpiresAt time.Time
+}
+
+// 密码重置令牌记录
+type PasswordResetToken struct {
+	ID          int
+	UserID      int
+	TokenHash   string
+	ExpiresAt   time.Time
+	CreatedByID int // 签发令牌的管理员
+}
@@ -0,0 +1,101 @@
+package store
+
+import (
+	"campus-activity-api/internal/models"
+	"context"
+	"strings"
+)
+
+type userRepo struct{ s *SQLStore }
+
+const userColumns = "id, username, password_hash, COALESCE(full_name, ''), COALESCE(college, ''), role"
+
+func (r userRepo) get(ctx context.Context, where string, arg interface{}, lock bool) (models.User, error) {
+	query := "SELECT " + userColumns + " FROM users WHERE " + where
+	if lock {
+		query += r.s.dialect.forUpdate
+	}
+	var u models.User
+	err := r.s.q.QueryRowContext(ctx, query, arg).
+		Scan(&u.ID, &u.Username, &u.PasswordHash, &u.FullName, &u.College, &u.Role)
+	return u, r.s.wrap(err)
+}
+
+func (r userRepo) Get(ctx context.Context, id int) (models.User, error) {
+	return r.get(ctx, "id = ?", id, false)
+}
+
+func (r userRepo) GetForUpdate(ctx context.Context, id int) (models.User, error) {
+	return r.get(ctx, "id = ?", id, true)
+}
+
+func (r userRepo) GetByUsername(ctx context.Context, username string) (models.User, error) {
+	return r.get(ctx, "username = ?", username, false)
+}
+
+func (r userRepo) Create(ctx context.Context, user *models.User) error {
+	result, err := r.s.q.ExecContext(ctx,
+		"INSERT INTO users (username, password_hash, full_name, college, role) VALUES (?, ?, ?, ?, ?)",
+		user.Username, user.PasswordHash, user.FullName, user.College, user.Role)
+	if err != nil {
+		return r.s.wrap(err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	user.ID = int(id)
+	return nil
+}
+
+// 每条 INSERT 语句插入的行数
+const userBatchSize = 100
+
+func (r userRepo) CreateBatch(ctx context.Context, users []models.User) error {
+	for start := 0; start < len(users); start += userBatchSize {
+		batch := users[start:min(start+userBatchSize, len(users))]
+
+		values := strings.TrimSuffix(strings.Repeat("(?, ?, ?, ?, ?), ", len(batch)), ", ")
+		args := make([]interface{}, 0, len(batch)*5)
+		for _, u := range batch {
+			args = append(args, u.Username, u.PasswordHash, u.FullName, u.College, u.Role)
+		}
+		if _, err := r.s.q.ExecContext(ctx,
+			"INSERT INTO users (username, password_hash, full_name, college, role) VALUES "+values, args...); err != nil {
+			return r.s.wrap(err)
+		}
+	}
+	return nil
+}
+
+func (r userRepo) UpdatePasswordHash(ctx context.Context, id int, passwordHash string) error {
+	_, err := r.s.q.ExecContext(ctx, "UPDATE users SET password_hash = ? WHERE id = ?", passwordHash, id)
+	return err
+}
+
+// 单条查询中 IN 列表的最大长度
+const usernameChunkSize = 500
+
+func (r userRepo) ExistingUsernames(ctx context.Context, usernames []string) (map[string]bool, error) {
+	existing := map[string]bool{}
+	for start := 0; start < len(usernames); start += usernameChunkSize {
+		in, args := inClause(usernames[start:min(start+usernameChunkSize, len(usernames))])
+		rows, err := r.s.q.QueryContext(ctx, "SELECT username FROM users WHERE username IN "+in, args...)
+		if err != nil {
+			return nil, err
+		}
+		for rows.Next() {
+			var username string
+			if err := rows.Scan(&username); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			existing[username] = true
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return existing, nil
+}